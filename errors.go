@@ -0,0 +1,18 @@
+package pgtypes
+
+import (
+	"github.com/apaxa-go/helper/strconvh"
+)
+
+// ParseError describes a problem with parsing text representation of some type.
+type ParseError struct {
+	Type   string // Name of the parsed type, e.g. "interval"
+	Str    string // Parsed string
+	Offset int    // Byte offset in Str where the problem was found
+	Reason string // Description of the problem
+}
+
+// Error implements the error interface.
+func (e *ParseError) Error() string {
+	return "unable to parse " + e.Type + " from string " + e.Str + ": " + e.Reason + " at offset " + strconvh.FormatInt(e.Offset)
+}
@@ -0,0 +1,12 @@
+package pgtypes
+
+import (
+	"testing"
+)
+
+func TestParseError_Error(t *testing.T) {
+	err := &ParseError{Type: "interval", Str: "1 dya", Offset: 2, Reason: `unknown unit "dya"`}
+	if s := err.Error(); s != `unable to parse interval from string 1 dya: unknown unit "dya" at offset 2` {
+		t.Errorf("expect %v, got %v", `unable to parse interval from string 1 dya: unknown unit "dya" at offset 2`, s)
+	}
+}
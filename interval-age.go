@@ -0,0 +1,58 @@
+package pgtypes
+
+import (
+	"github.com/apaxa-go/helper/timeh"
+	"time"
+)
+
+// wallClock returns time with the same wall clock as t, but in UTC.
+func wallClock(t time.Time) time.Time {
+	y, m, d := t.Date()
+	h, min, s := t.Clock()
+	return time.Date(y, m, d, h, min, s, t.Nanosecond(), time.UTC)
+}
+
+// age returns symbolic difference between wall clocks from and to (=to-from) in the same way as PostgreSQL age(timestamp, timestamp) does.
+func age(from, to time.Time) Interval {
+	negative := to.Before(from)
+	if negative {
+		from, to = to, from
+	}
+	fromYear, fromMonth, fromDay := from.Date()
+	toYear, toMonth, toDay := to.Date()
+
+	months := (toYear-fromYear)*timeh.MonthsInYear + int(toMonth-fromMonth)
+	days := toDay - fromDay
+	ns := int64(to.Sub(time.Date(toYear, toMonth, toDay, 0, 0, 0, 0, time.UTC)) - from.Sub(time.Date(fromYear, fromMonth, fromDay, 0, 0, 0, 0, time.UTC)))
+
+	// Borrow negative fields from the next higher field, days are borrowed from the month of the earlier timestamp
+	if ns < 0 {
+		ns += int64(timeh.SecsInDay * time.Second)
+		days--
+	}
+	fromMonthDays := time.Date(fromYear, fromMonth+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	for days < 0 {
+		days += fromMonthDays
+		months--
+	}
+
+	i := Interval{Months: int32(months), Days: int32(days), SomeSeconds: ns, precision: IntervalGoPrecision}
+	if negative {
+		i = Interval{Months: -i.Months, Days: -i.Days, SomeSeconds: -i.SomeSeconds, precision: i.precision}
+	}
+	return i
+}
+
+// Age returns symbolic difference between given timestamps (=to-from) in the same way as PostgreSQL age(to, from) for timestamp without time zone does.
+// Wall clock of each timestamp (in its own Location) is used.
+// Unlike DiffExtended, all parts of result have the same sign, and if day of to is less than day of from then days are borrowed from the month of the earlier timestamp,
+// so age from "2021-01-31" to "2021-03-01" is "1 mon 1 day".
+// Result has nanosecond precision, its days part is less than 31 and seconds part is less than a day.
+func Age(from, to time.Time) Interval {
+	return age(wallClock(from), wallClock(to))
+}
+
+// AgeIn is the same as Age, but it converts both timestamps to location loc first (as PostgreSQL age(timestamptz, timestamptz) does with time zone set to loc).
+func AgeIn(from, to time.Time, loc *time.Location) Interval {
+	return Age(from.In(loc), to.In(loc))
+}
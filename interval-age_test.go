@@ -0,0 +1,88 @@
+package pgtypes
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAge(t *testing.T) {
+	type testElement struct {
+		from string
+		to   string
+		i    Interval
+	}
+
+	const p = IntervalNanosecondPrecision
+	const h = 3600 * 1e9
+	// Results are the same as in PostgreSQL age(to, from)
+	test := []testElement{
+		{"1957-06-13T00:00:00Z", "2001-04-10T00:00:00Z", Interval{525, 27, 0, p}},
+		{"2001-04-10T00:00:00Z", "1957-06-13T00:00:00Z", Interval{-525, -27, 0, p}},
+		{"2021-01-31T00:00:00Z", "2021-03-01T00:00:00Z", Interval{1, 1, 0, p}},
+		{"2021-03-01T00:00:00Z", "2021-01-31T00:00:00Z", Interval{-1, -1, 0, p}},
+		{"2021-01-31T00:00:00Z", "2021-02-28T00:00:00Z", Interval{0, 28, 0, p}},
+		{"2021-02-28T00:00:00Z", "2021-03-31T00:00:00Z", Interval{1, 3, 0, p}},
+		{"2021-03-31T00:00:00Z", "2021-02-28T00:00:00Z", Interval{-1, -3, 0, p}},
+		{"2021-02-28T23:00:00Z", "2021-03-01T00:00:00Z", Interval{0, 0, h, p}},
+		{"2020-02-29T12:00:00.5Z", "2021-02-28T11:00:00Z", Interval{11, 27, 23*h - 5e8, p}},
+		{"2021-01-01T00:00:00Z", "2021-01-01T00:00:00.000000001Z", Interval{0, 0, 1, p}},
+		{"2021-01-01T00:00:00Z", "2021-01-01T00:00:00Z", Interval{0, 0, 0, p}},
+		// Wall clocks are used
+		{"2021-01-01T00:00:00+03:00", "2021-01-01T00:00:00Z", Interval{0, 0, 0, p}},
+		{"2021-01-01T23:00:00-03:00", "2021-01-02T01:00:00+03:00", Interval{0, 0, 2 * h, p}},
+	}
+
+	for _, v := range test {
+		from, err := time.Parse(time.RFC3339Nano, v.from)
+		if err != nil {
+			t.Errorf("%v: error %v", v.from, err)
+		}
+		to, err := time.Parse(time.RFC3339Nano, v.to)
+		if err != nil {
+			t.Errorf("%v: error %v", v.to, err)
+		}
+		if i := Age(from, to); i != v.i {
+			t.Errorf("%v,%v: expect %v, got %v", v.from, v.to, v.i, i)
+		}
+	}
+}
+
+func TestAgeIn(t *testing.T) {
+	type testElement struct {
+		from string
+		to   string
+		loc  *time.Location
+		i    Interval
+	}
+
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone database is not available:", err)
+	}
+	const p = IntervalNanosecondPrecision
+	const h = 3600 * 1e9
+	// Results are the same as in PostgreSQL age(to, from) for timestamptz
+	test := []testElement{
+		{"2021-03-14T05:00:00Z", "2021-03-15T04:00:00Z", ny, Interval{0, 1, 0, p}},
+		{"2021-03-14T05:00:00Z", "2021-03-15T04:00:00Z", time.UTC, Interval{0, 0, 23 * h, p}},
+		{"2021-02-01T03:00:00Z", "2021-03-01T03:00:00Z", ny, Interval{0, 28, 0, p}},
+		{"2021-02-01T03:00:00Z", "2021-03-01T03:00:00Z", time.UTC, Interval{1, 0, 0, p}},
+		{"2021-02-01T03:00:00Z", "2021-03-02T03:00:00Z", ny, Interval{1, 1, 0, p}},
+		{"2021-01-31T12:00:00Z", "2021-03-01T04:00:00Z", ny, Interval{0, 28, 16 * h, p}},
+		{"2021-03-01T04:00:00Z", "2021-01-31T12:00:00Z", ny, Interval{0, -28, -16 * h, p}},
+	}
+
+	for _, v := range test {
+		from, err := time.Parse(time.RFC3339Nano, v.from)
+		if err != nil {
+			t.Errorf("%v: error %v", v.from, err)
+		}
+		to, err := time.Parse(time.RFC3339Nano, v.to)
+		if err != nil {
+			t.Errorf("%v: error %v", v.to, err)
+		}
+		if i := AgeIn(from, to, v.loc); i != v.i {
+			t.Errorf("%v,%v,%v: expect %v, got %v", v.from, v.to, v.loc, v.i, i)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package pgtypes
+
+import (
+	"github.com/apaxa-go/helper/mathh"
+)
+
+// validIntervals returns valid (non-NULL) values from s.
+func validIntervals(s []NullInterval) []Interval {
+	r := make([]Interval, 0, len(s))
+	for _, v := range s {
+		if v.Valid {
+			r = append(r, v.Interval)
+		}
+	}
+	return r
+}
+
+// SumIntervals returns sum of intervals in the same way as PostgreSQL sum(interval) aggregate does: parts of intervals are summed up independently, so result is not justified.
+// Result has the greatest precision of intervals in s, so it is exact.
+// Result is NULL (Valid is false) if s is empty.
+// It returns error if any part of sum (including intermediate sums) overflows.
+func SumIntervals(s []Interval) (NullInterval, error) {
+	if len(s) == 0 {
+		return NullInterval{}, nil
+	}
+	var p uint8
+	for _, v := range s {
+		p = mathh.Max2Uint8(p, v.precision)
+	}
+	r := NewInterval(p)
+	for _, v := range s {
+		var err error
+		if r, err = r.AddChecked(v); err != nil {
+			return NullInterval{}, err
+		}
+	}
+	return NullInterval{Interval: r, Valid: true}, nil
+}
+
+// AvgIntervals returns average of intervals in the same way as PostgreSQL avg(interval) aggregate does: sum of intervals (as by SumIntervals) is divided by number of intervals using DivFloat64.
+// So fractional parts cascade from months to days and from days to seconds, for example average of "1 mon" and "0" is "15 days".
+// Result is NULL (Valid is false) if s is empty.
+// It returns error if sum or result overflows.
+func AvgIntervals(s []Interval) (NullInterval, error) {
+	sum, err := SumIntervals(s)
+	if err != nil || !sum.Valid {
+		return sum, err
+	}
+	if sum.Interval, err = sum.Interval.DivFloat64(float64(len(s))); err != nil {
+		return NullInterval{}, err
+	}
+	return sum, nil
+}
+
+// MinInterval returns the smallest interval (as by CmpTotal) in the same way as PostgreSQL min(interval) aggregate does.
+// If there are several intervals equal to the smallest one (e.g. "1 mon" and "30 days") then the last of them is returned.
+// Result is NULL (Valid is false) if s is empty.
+func MinInterval(s []Interval) NullInterval {
+	if len(s) == 0 {
+		return NullInterval{}
+	}
+	r := s[0]
+	for _, v := range s[1:] {
+		if r.CmpTotal(v) >= 0 {
+			r = v
+		}
+	}
+	return NullInterval{Interval: r, Valid: true}
+}
+
+// MaxInterval returns the largest interval (as by CmpTotal) in the same way as PostgreSQL max(interval) aggregate does.
+// If there are several intervals equal to the largest one (e.g. "1 mon" and "30 days") then the last of them is returned.
+// Result is NULL (Valid is false) if s is empty.
+func MaxInterval(s []Interval) NullInterval {
+	if len(s) == 0 {
+		return NullInterval{}
+	}
+	r := s[0]
+	for _, v := range s[1:] {
+		if r.CmpTotal(v) <= 0 {
+			r = v
+		}
+	}
+	return NullInterval{Interval: r, Valid: true}
+}
+
+// SumNullIntervals is the same as SumIntervals, but NULL values (with Valid set to false) are skipped (as in PostgreSQL).
+func SumNullIntervals(s []NullInterval) (NullInterval, error) {
+	return SumIntervals(validIntervals(s))
+}
+
+// AvgNullIntervals is the same as AvgIntervals, but NULL values (with Valid set to false) are skipped and are not counted (as in PostgreSQL).
+func AvgNullIntervals(s []NullInterval) (NullInterval, error) {
+	return AvgIntervals(validIntervals(s))
+}
+
+// MinNullInterval is the same as MinInterval, but NULL values (with Valid set to false) are skipped (as in PostgreSQL).
+func MinNullInterval(s []NullInterval) NullInterval {
+	return MinInterval(validIntervals(s))
+}
+
+// MaxNullInterval is the same as MaxInterval, but NULL values (with Valid set to false) are skipped (as in PostgreSQL).
+func MaxNullInterval(s []NullInterval) NullInterval {
+	return MaxInterval(validIntervals(s))
+}
@@ -0,0 +1,119 @@
+package pgtypes
+
+import (
+	"github.com/apaxa-go/helper/mathh"
+	"testing"
+)
+
+func TestSumIntervals(t *testing.T) {
+	type testElement struct {
+		s  []Interval
+		r  NullInterval
+		ok bool
+	}
+
+	const p = IntervalMicrosecondPrecision
+	const hour = 3600 * 1e6
+	test := []testElement{
+		{nil, NullInterval{}, true},
+		{[]Interval{{1, 2, 3 * hour, p}}, NullInterval{Interval{1, 2, 3 * hour, p}, true}, true},
+		{[]Interval{{1, 2, 3 * hour, p}, {0, -1, 25 * hour, p}, {12, 0, 0, p}}, NullInterval{Interval{13, 1, 28 * hour, p}, true}, true},
+		{[]Interval{{0, 0, 15, 1}, {0, 0, 123, IntervalMillisecondPrecision}}, NullInterval{Interval{0, 0, 1623, IntervalMillisecondPrecision}, true}, true},
+		{[]Interval{{mathh.MaxInt32, 0, 0, p}, {1, 0, 0, p}, {-1, 0, 0, p}}, NullInterval{}, false},
+		{[]Interval{{0, 0, mathh.MaxInt64, p}, {0, 0, 1, p}}, NullInterval{}, false},
+	}
+
+	for _, v := range test {
+		if r, err := SumIntervals(v.s); (err == nil) != v.ok || r != v.r {
+			t.Errorf("%v: expect %v %v, got %v %v", v.s, v.r, v.ok, r, err)
+		}
+	}
+}
+
+func TestAvgIntervals(t *testing.T) {
+	type testElement struct {
+		s  []Interval
+		r  NullInterval
+		ok bool
+	}
+
+	const p = IntervalMicrosecondPrecision
+	test := []testElement{
+		{nil, NullInterval{}, true},
+		{[]Interval{{1, 0, 0, p}, {0, 0, 0, p}}, NullInterval{Interval{0, 15, 0, p}, true}, true},
+		{[]Interval{{1, 0, 0, p}, {0, 1, 0, p}, {0, 0, 1e6, p}}, NullInterval{Interval{0, 10, 28800333333, p}, true}, true},
+		{[]Interval{{0, 0, 1, p}, {0, 0, 2, p}}, NullInterval{Interval{0, 0, 2, p}, true}, true},
+		{[]Interval{{0, 0, -1, p}, {0, 0, -2, p}}, NullInterval{Interval{0, 0, -2, p}, true}, true},
+		{[]Interval{{mathh.MaxInt32, 0, 0, p}, {mathh.MaxInt32, 0, 0, p}}, NullInterval{}, false},
+	}
+
+	for _, v := range test {
+		if r, err := AvgIntervals(v.s); (err == nil) != v.ok || r != v.r {
+			t.Errorf("%v: expect %v %v, got %v %v", v.s, v.r, v.ok, r, err)
+		}
+	}
+}
+
+func TestMinMaxInterval(t *testing.T) {
+	type testElement struct {
+		s        []Interval
+		min, max NullInterval
+	}
+
+	const p = IntervalMicrosecondPrecision
+	const day = 86400 * 1e6
+	test := []testElement{
+		{nil, NullInterval{}, NullInterval{}},
+		{[]Interval{{0, 1, 0, p}}, NullInterval{Interval{0, 1, 0, p}, true}, NullInterval{Interval{0, 1, 0, p}, true}},
+		{[]Interval{{1, 0, 0, p}, {0, 30, 0, p}, {0, -1, 0, p}}, NullInterval{Interval{0, -1, 0, p}, true}, NullInterval{Interval{0, 30, 0, p}, true}},
+		{[]Interval{{0, 30, 0, p}, {1, 0, 0, p}}, NullInterval{Interval{1, 0, 0, p}, true}, NullInterval{Interval{1, 0, 0, p}, true}},
+		{[]Interval{{1, 0, 0, p}, {0, 29, day, p}, {0, 1, 0, p}}, NullInterval{Interval{0, 1, 0, p}, true}, NullInterval{Interval{0, 29, day, p}, true}},
+		{[]Interval{{0, 0, 1, IntervalSecondPrecision}, {0, 0, 999999, p}}, NullInterval{Interval{0, 0, 999999, p}, true}, NullInterval{Interval{0, 0, 1, IntervalSecondPrecision}, true}},
+	}
+
+	for _, v := range test {
+		if r := MinInterval(v.s); r != v.min {
+			t.Errorf("%v: expect %v, got %v", v.s, v.min, r)
+		}
+		if r := MaxInterval(v.s); r != v.max {
+			t.Errorf("%v: expect %v, got %v", v.s, v.max, r)
+		}
+	}
+}
+
+func TestNullIntervalsAggregates(t *testing.T) {
+	const p = IntervalMicrosecondPrecision
+	s := []NullInterval{
+		{Interval{1, 0, 0, p}, true},
+		{Interval{0, 100, 0, p}, false},
+		{Interval{0, 0, 0, p}, true},
+		{Interval{0, -100, 0, p}, false},
+	}
+
+	if r, err := SumNullIntervals(s); err != nil || r != (NullInterval{Interval{1, 0, 0, p}, true}) {
+		t.Errorf("sum: expect %v, got %v %v", Interval{1, 0, 0, p}, r, err)
+	}
+	if r, err := AvgNullIntervals(s); err != nil || r != (NullInterval{Interval{0, 15, 0, p}, true}) {
+		t.Errorf("avg: expect %v, got %v %v", Interval{0, 15, 0, p}, r, err)
+	}
+	if r := MinNullInterval(s); r != (NullInterval{Interval{0, 0, 0, p}, true}) {
+		t.Errorf("min: expect %v, got %v", Interval{0, 0, 0, p}, r)
+	}
+	if r := MaxNullInterval(s); r != (NullInterval{Interval{1, 0, 0, p}, true}) {
+		t.Errorf("max: expect %v, got %v", Interval{1, 0, 0, p}, r)
+	}
+
+	s = []NullInterval{{}, {}}
+	if r, err := SumNullIntervals(s); err != nil || r.Valid {
+		t.Errorf("sum of nulls: expect null, got %v %v", r, err)
+	}
+	if r, err := AvgNullIntervals(s); err != nil || r.Valid {
+		t.Errorf("avg of nulls: expect null, got %v %v", r, err)
+	}
+	if r := MinNullInterval(s); r.Valid {
+		t.Errorf("min of nulls: expect null, got %v", r)
+	}
+	if r := MaxNullInterval(s); r.Valid {
+		t.Errorf("max of nulls: expect null, got %v", r)
+	}
+}
@@ -0,0 +1,69 @@
+package pgtypes
+
+import (
+	"github.com/apaxa-go/helper/timeh"
+	"sort"
+)
+
+// span splits interval into total number of days (with 30 days per month and 24 hours per day) and non-negative remainder less than a day (in units of interval precision).
+// Together they are the same as PostgreSQL interval_cmp_value, but without 128-bit arithmetic.
+func (i Interval) span() (days, rem int64) {
+	day := someSecondsInDay(i.precision)
+	days, rem = i.SomeSeconds/day, i.SomeSeconds%day
+	if rem < 0 {
+		rem += day
+		days--
+	}
+	return days + int64(i.Months)*timeh.DaysInMonth + int64(i.Days), rem
+}
+
+// CmpTotal compares i and i2 in the same way as PostgreSQL does: intervals are converted to a single value using 30 days per month and 24 hours per day.
+// Unlike Cmp it defines total order, so all Intervals are comparable, for example "1 mon" equals to "30 days" and "1 day" equals to "24:00:00".
+// Intervals with different precisions are compared exactly.
+// Returns:
+//
+//	-1 if i <  i2
+//	 0 if i == i2
+//	+1 if i >  i2
+func (i Interval) CmpTotal(i2 Interval) int {
+	d1, r1 := i.span()
+	d2, r2 := i2.span()
+	if d1 != d2 {
+		if d1 < d2 {
+			return -1
+		}
+		return 1
+	}
+	// Remainders are less than a day, so they can be converted to the greater precision without overflow
+	if i.precision < i2.precision {
+		r1 = someSecondsChangePrecision(r1, i.precision, i2.precision)
+	} else {
+		r2 = someSecondsChangePrecision(r2, i2.precision, i.precision)
+	}
+	switch {
+	case r1 < r2:
+		return -1
+	case r1 > r2:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// CompareIntervals returns a.CmpTotal(b).
+// It is useful as comparison function for sorting and searching.
+func CompareIntervals(a, b Interval) int {
+	return a.CmpTotal(b)
+}
+
+// IntervalSlice attaches the methods of sort.Interface to []Interval, sorting in increasing order defined by CmpTotal.
+type IntervalSlice []Interval
+
+func (s IntervalSlice) Len() int           { return len(s) }
+func (s IntervalSlice) Less(i, j int) bool { return s[i].CmpTotal(s[j]) < 0 }
+func (s IntervalSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// SortIntervals sorts a slice of Intervals in increasing order defined by CmpTotal.
+func SortIntervals(s []Interval) {
+	sort.Sort(IntervalSlice(s))
+}
@@ -0,0 +1,79 @@
+package pgtypes
+
+import (
+	"github.com/apaxa-go/helper/mathh"
+	"testing"
+	"testing/quick"
+)
+
+func TestInterval_CmpTotal(t *testing.T) {
+	type testElement struct {
+		i1, i2 Interval
+		r      int
+	}
+
+	const p = IntervalMicrosecondPrecision
+	const day = 86400 * 1e6
+	test := []testElement{
+		{Interval{1, 0, 0, p}, Interval{0, 30, 0, p}, 0},
+		{Interval{0, 1, 0, p}, Interval{0, 0, day, p}, 0},
+		{Interval{1, 0, 0, p}, Interval{0, 0, 30 * day, p}, 0},
+		{Interval{1, 0, 0, p}, Interval{0, 29, day + 1, p}, -1},
+		{Interval{1, 0, 0, p}, Interval{0, 31, -day - 1, p}, 1},
+		{Interval{0, -1, 0, p}, Interval{0, 0, -1, p}, -1},
+		{Interval{0, 0, 0, p}, Interval{0, 0, 0, p}, 0},
+		{Interval{0, 0, 1, IntervalMillisecondPrecision}, Interval{0, 0, 1000, p}, 0},
+		{Interval{0, 0, 1, IntervalMillisecondPrecision}, Interval{0, 0, 1001, p}, -1},
+		{Interval{0, 0, -1, IntervalMillisecondPrecision}, Interval{0, 0, -1001, p}, 1},
+		{Interval{0, 1, 0, IntervalSecondPrecision}, Interval{0, 0, 86400 * 1e12, IntervalPicosecondPrecision}, 0},
+		{Interval{mathh.MaxInt32, mathh.MaxInt32, mathh.MaxInt64, IntervalPicosecondPrecision}, Interval{mathh.MaxInt32, mathh.MaxInt32, mathh.MaxInt64, IntervalSecondPrecision}, -1},
+		{Interval{mathh.MinInt32, mathh.MinInt32, mathh.MinInt64, IntervalPicosecondPrecision}, Interval{mathh.MinInt32, mathh.MinInt32, mathh.MinInt64, IntervalSecondPrecision}, 1},
+		{Interval{mathh.MaxInt32, 0, 0, p}, Interval{0, mathh.MaxInt32, mathh.MaxInt64, p}, 1},
+	}
+
+	for _, v := range test {
+		if r := v.i1.CmpTotal(v.i2); r != v.r {
+			t.Errorf("%#v, %#v: expect %v, got %v", v.i1, v.i2, v.r, r)
+		}
+		if r := CompareIntervals(v.i2, v.i1); r != -v.r {
+			t.Errorf("%#v, %#v: expect %v, got %v", v.i2, v.i1, -v.r, r)
+		}
+	}
+}
+
+// CmpTotal must agree with Cmp for all comparable Intervals.
+func TestInterval_CmpTotalQuick(t *testing.T) {
+	f := func(i1, i2 Interval) bool {
+		if sign, ok := i1.Cmp(i2); ok && sign != i1.CmpTotal(i2) {
+			return false
+		}
+		return i1.CmpTotal(i1) == 0 && i1.CmpTotal(i2) == -i2.CmpTotal(i1)
+	}
+	if err := quick.Check(f, quickConfig(10000)); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSortIntervals(t *testing.T) {
+	const p = IntervalMicrosecondPrecision
+	s := []Interval{
+		{0, 31, 0, p},
+		{0, 0, -1, p},
+		{1, 0, 0, p},
+		{0, 0, 0, p},
+		{0, 29, 25 * 3600 * 1e6, p},
+	}
+	expect := []Interval{
+		{0, 0, -1, p},
+		{0, 0, 0, p},
+		{1, 0, 0, p},
+		{0, 29, 25 * 3600 * 1e6, p},
+		{0, 31, 0, p},
+	}
+	SortIntervals(s)
+	for j := range s {
+		if s[j] != expect[j] {
+			t.Errorf("#%v: expect %v, got %v", j, expect[j], s[j])
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package pgtypes
+
+import (
+	"github.com/apaxa-go/helper/strconvh"
+	"github.com/apaxa-go/helper/timeh"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ParseIntervalCompact parses interval in compact form similar to time.ParseDuration input (e.g. "1h30m", "2d", "-1y3mo") with requested precision p.
+// Each field is a decimal number (with optional sign and fraction) followed by unit: "ns", "us" (or "µs"), "ms", "s", "m", "h", "d", "w", "mo" or "y".
+// Months and days are kept in separate parts of Interval, fractional part of years cascades into months, of months and weeks into days and of days into seconds
+// (in the same way as ParseInterval does).
+// As in time.ParseDuration, leading sign applies to all fields, but only if no other field has explicit sign, so "-1h30m" is -90 minutes, while "-1mo+3d" is minus one month plus three days.
+// Fields may repeat, their values are summed up. Special string "0" is a zero interval.
+// If string can not be parsed then returned error is *ParseError.
+func ParseIntervalCompact(s string, p uint8) (Interval, error) {
+	if s == "" {
+		return Interval{}, errIntervalParse(s, 0, "empty interval")
+	}
+	fail := func(rest, reason string) (Interval, error) {
+		return Interval{}, errIntervalParse(s, len(s)-len(rest), reason)
+	}
+
+	b := newIntervalBuilder(p)
+	str := s
+	if str[0] == '-' || str[0] == '+' {
+		// Leading sign applies to all fields if there are no other explicit signs
+		if !strings.ContainsAny(str[1:], "+-") {
+			b.negative = str[0] == '-'
+			str = str[1:]
+		}
+	}
+	if str == "0" {
+		return b.result()
+	}
+	if str == "" {
+		return fail(str, "missing interval fields")
+	}
+
+	for str != "" {
+		fieldStart := str
+		n, rest, ok := parseIntervalNumber(str)
+		if !ok {
+			return fail(str, "invalid number")
+		}
+		str = rest
+		l := 0
+		for l < len(str) && (isLetter(str[l]) || str[l] >= utf8.RuneSelf) {
+			l++
+		}
+		unit := str[:l]
+
+		switch unit {
+		case "ns":
+			addIntervalSubSeconds(&b, n, 1e9)
+		case "us", "µs", "μs":
+			addIntervalSubSeconds(&b, n, 1e6)
+		case "ms":
+			addIntervalSubSeconds(&b, n, 1e3)
+		case "s":
+			b.addSeconds(n.ipart, 1)
+			b.addFracSeconds(n.frac, 1)
+		case "m":
+			b.addSeconds(n.ipart, timeh.SecsInMin)
+			b.addFracSeconds(n.frac, timeh.SecsInMin)
+		case "h":
+			b.addSeconds(n.ipart, timeh.SecsInHour)
+			b.addFracSeconds(n.frac, timeh.SecsInHour)
+		case "d":
+			b.addDays(n.ipart, 1)
+			b.addFracSeconds(n.frac, timeh.SecsInDay)
+		case "w":
+			b.addDays(n.ipart, 7)
+			b.addFracDays(n.frac, 7)
+		case "mo":
+			b.addMonths(n.ipart, 1)
+			b.addFracDays(n.frac, timeh.DaysInMonth)
+		case "y":
+			b.addMonths(n.ipart, timeh.MonthsInYear)
+			b.addFracMonths(n.frac, timeh.MonthsInYear)
+		case "":
+			return fail(str, "missing unit after number "+strconv.Quote(fieldStart[:len(fieldStart)-len(str)]))
+		default:
+			return fail(str, "unknown unit "+strconv.Quote(unit))
+		}
+		if b.overflow {
+			return fail(fieldStart, "out of range field "+strconv.Quote(fieldStart[:len(fieldStart)-len(str)+l]))
+		}
+		str = str[l:]
+	}
+	return b.result()
+}
+
+// CompactString returns interval in compact form accepted by ParseIntervalCompact, e.g. "1y2mo3d4h5m6.789s".
+// Hours are not justified to days and days are not justified to months, weeks are never used.
+// If all non-zero fields are negative then output has single leading minus (as time.Duration.String), otherwise each negative field has explicit minus and each positive field after the first one has explicit plus:
+// "-1y2mo3d", "1mo-3d", "-1mo+3d".
+// Zero interval is "0s".
+func (i Interval) CompactString() string {
+	type field struct {
+		negative bool
+		s        string
+	}
+	var fields []field
+	add := func(negative bool, v uint64, unit string) {
+		if v != 0 {
+			fields = append(fields, field{negative, strconvh.FormatUint64(v) + unit})
+		}
+	}
+	abs := func(v int32) uint64 {
+		if v < 0 {
+			return uint64(-int64(v))
+		}
+		return uint64(v)
+	}
+
+	add(i.Months < 0, abs(i.Months/timeh.MonthsInYear), "y")
+	add(i.Months < 0, abs(i.Months%timeh.MonthsInYear), "mo")
+	add(i.Days < 0, abs(i.Days), "d")
+	negative, h, m, s, f := intervalSecondsParts(i.SomeSeconds, i.precision)
+	add(negative, h, "h")
+	add(negative, m, "m")
+	if s != 0 || f != 0 {
+		fields = append(fields, field{negative, strconvh.FormatUint64(s) + formatIntervalFrac(f, i.precision) + "s"})
+	}
+
+	if len(fields) == 0 {
+		return "0s"
+	}
+	allNegative, mixed := true, false
+	for _, v := range fields {
+		allNegative = allNegative && v.negative
+		mixed = mixed || v.negative != fields[0].negative
+	}
+
+	var r string
+	if allNegative {
+		r = "-"
+	}
+	for k, v := range fields {
+		switch {
+		case allNegative:
+		case v.negative:
+			r += "-"
+		case k > 0 && mixed:
+			r += "+"
+		}
+		r += v.s
+	}
+	return r
+}
@@ -0,0 +1,124 @@
+package pgtypes
+
+import (
+	"github.com/apaxa-go/helper/mathh"
+	"testing"
+)
+
+func TestParseIntervalCompact(t *testing.T) {
+	type testElement struct {
+		s   string
+		i   Interval
+		err bool
+	}
+
+	const p = IntervalMicrosecondPrecision
+	const sec = 1e6
+	test := []testElement{
+		{"0", Interval{0, 0, 0, p}, false},
+		{"-0", Interval{0, 0, 0, p}, false},
+		{"0s", Interval{0, 0, 0, p}, false},
+		{"1h30m", Interval{0, 0, 5400 * sec, p}, false},
+		{"-1h30m", Interval{0, 0, -5400 * sec, p}, false},
+		{"+1h30m", Interval{0, 0, 5400 * sec, p}, false},
+		{"2d", Interval{0, 2, 0, p}, false},
+		{"3mo", Interval{3, 0, 0, p}, false},
+		{"1y2mo3d4h5m6.789s", Interval{14, 3, 14706789 * 1e3, p}, false},
+		{"-1y2mo3d", Interval{-14, -3, 0, p}, false},
+		{"1mo-3d", Interval{1, -3, 0, p}, false},
+		{"-1mo+3d", Interval{-1, 3, 0, p}, false},
+		{"1h-1h", Interval{0, 0, 0, p}, false},
+		{"1h1h", Interval{0, 0, 7200 * sec, p}, false},
+		{"2w", Interval{0, 14, 0, p}, false},
+		{"1.5w", Interval{0, 10, 43200 * sec, p}, false},
+		{"1.5y", Interval{18, 0, 0, p}, false},
+		{"1.5mo", Interval{1, 15, 0, p}, false},
+		{"1.5d", Interval{0, 1, 43200 * sec, p}, false},
+		{"1.5h", Interval{0, 0, 5400 * sec, p}, false},
+		{".5s", Interval{0, 0, 500000, p}, false},
+		{"1s500ms7us", Interval{0, 0, 1500007, p}, false},
+		{"7µs", Interval{0, 0, 7, p}, false},
+		{"1500ns", Interval{0, 0, 2, p}, false},
+		{"-1500ns", Interval{0, 0, -2, p}, false},
+		{"1.5ms", Interval{0, 0, 1500, p}, false},
+		{"9223372036854775807us", Interval{0, 0, mathh.MaxInt64, p}, false},
+		// Errors
+		{"", Interval{}, true},
+		{"-", Interval{}, true},
+		{"1", Interval{}, true},
+		{"1h30", Interval{}, true},
+		{"1x", Interval{}, true},
+		{"1H", Interval{}, true},
+		{"1 h", Interval{}, true},
+		{"h", Interval{}, true},
+		{"1h--1m", Interval{}, true},
+		{"2147483648d", Interval{}, true},
+		{"178956971y", Interval{}, true},
+		{"9223372036854775807us1us", Interval{}, true},
+	}
+
+	for _, v := range test {
+		i, err := ParseIntervalCompact(v.s, p)
+		if (err != nil) != v.err || !v.err && i != v.i {
+			t.Errorf("%v: expect %#v %v, got %#v %v", v.s, v.i, v.err, i, err)
+		}
+	}
+}
+
+func TestParseIntervalCompact_Error(t *testing.T) {
+	type testElement struct {
+		s      string
+		offset int
+		reason string
+	}
+
+	test := []testElement{
+		{"", 0, "empty interval"},
+		{"-", 1, "missing interval fields"},
+		{"1h30", 4, `missing unit after number "30"`},
+		{"1h2x", 3, `unknown unit "x"`},
+		{"1h h", 2, "invalid number"},
+		{"1d2147483648d", 2, `out of range field "2147483648d"`},
+	}
+
+	for _, v := range test {
+		_, err := ParseIntervalCompact(v.s, IntervalMicrosecondPrecision)
+		if e, ok := err.(*ParseError); !ok || e.Type != "interval" || e.Str != v.s || e.Offset != v.offset || e.Reason != v.reason {
+			t.Errorf("%v: expect error at %v with reason %v, got %#v", v.s, v.offset, v.reason, err)
+		}
+	}
+}
+
+func TestInterval_CompactString(t *testing.T) {
+	type testElement struct {
+		i Interval
+		s string
+	}
+
+	const p = IntervalMicrosecondPrecision
+	const sec = 1e6
+	test := []testElement{
+		{Interval{0, 0, 0, p}, "0s"},
+		{Interval{14, 3, 14706789 * 1e3, p}, "1y2mo3d4h5m6.789s"},
+		{Interval{-14, -3, -14706789 * 1e3, p}, "-1y2mo3d4h5m6.789s"},
+		{Interval{1, -3, 0, p}, "1mo-3d"},
+		{Interval{-1, 3, 0, p}, "-1mo+3d"},
+		{Interval{-12, 3, 0, p}, "-1y+3d"},
+		{Interval{1, 0, -5400 * sec, p}, "1mo-1h-30m"},
+		{Interval{0, 0, 90000 * sec, p}, "25h"},
+		{Interval{0, 0, 500000, p}, "0.5s"},
+		{Interval{0, 0, 1, IntervalPicosecondPrecision}, "0.000000000001s"},
+		{Interval{0, 35, 0, p}, "35d"},
+		{Interval{mathh.MinInt32, mathh.MinInt32, mathh.MinInt64, IntervalSecondPrecision}, "-178956970y8mo2147483648d2562047788015215h30m8s"},
+		{Interval{mathh.MaxInt32, mathh.MaxInt32, mathh.MaxInt64, IntervalSecondPrecision}, "178956970y7mo2147483647d2562047788015215h30m7s"},
+	}
+
+	for _, v := range test {
+		if s := v.i.CompactString(); s != v.s {
+			t.Errorf("%v: expect %v, got %v", v.i, v.s, s)
+		}
+		if i, err := ParseIntervalCompact(v.s, v.i.Precision()); err != nil || i != v.i {
+			t.Errorf("%v: expect %v, got %v %v", v.s, v.i, i, err)
+		}
+	}
+}
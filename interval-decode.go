@@ -0,0 +1,421 @@
+package pgtypes
+
+import (
+	"github.com/apaxa-go/helper/mathh"
+	"github.com/apaxa-go/helper/timeh"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Kinds of fields in interval text representation (similar to PostgreSQL ParseDateTime).
+const (
+	intervalFieldNumber = iota // Unsigned number with optional fraction: "1", "1.5", ".5"
+	intervalFieldTime          // Unsigned time: "04:05:06.789", "04:05"
+	intervalFieldDate          // Unsigned year-month: "1-2"
+	intervalFieldSigned        // Signed number, year-month or time: "-1.5", "+1-2", "-04:05:06"
+	intervalFieldString        // Word in lower case: "days", "ago"
+)
+
+// Units of numbers in interval text representation (similar to PostgreSQL DTK_* constants).
+// Unit is also a bit number in the mask of already decoded fields.
+const (
+	intervalDtkNone = iota
+	intervalDtkMicrosecond
+	intervalDtkMillisecond
+	intervalDtkSecond
+	intervalDtkMinute
+	intervalDtkHour
+	intervalDtkDay
+	intervalDtkWeek
+	intervalDtkMonth
+	intervalDtkYear
+	intervalDtkDecade
+	intervalDtkCentury
+	intervalDtkMillennium
+	intervalDtkAgo
+)
+
+// Masks of fields which are set by seconds with fraction and by time field.
+const (
+	intervalAllSecsMask = 1<<intervalDtkSecond | 1<<intervalDtkMillisecond | 1<<intervalDtkMicrosecond
+	intervalTimeMask    = 1<<intervalDtkHour | 1<<intervalDtkMinute | intervalAllSecsMask
+)
+
+// intervalUnitMaxLen is a maximum significant length of unit name, the rest is ignored (as in PostgreSQL).
+const intervalUnitMaxLen = 10
+
+// intervalUnits maps unit names (including abbreviations) to units.
+// It is the same as PostgreSQL deltatktbl.
+var intervalUnits = map[string]int{
+	"us":         intervalDtkMicrosecond,
+	"usec":       intervalDtkMicrosecond,
+	"usecs":      intervalDtkMicrosecond,
+	"usecond":    intervalDtkMicrosecond,
+	"useconds":   intervalDtkMicrosecond,
+	"microsecon": intervalDtkMicrosecond,
+	"ms":         intervalDtkMillisecond,
+	"msec":       intervalDtkMillisecond,
+	"msecs":      intervalDtkMillisecond,
+	"msecond":    intervalDtkMillisecond,
+	"mseconds":   intervalDtkMillisecond,
+	"millisecon": intervalDtkMillisecond,
+	"s":          intervalDtkSecond,
+	"sec":        intervalDtkSecond,
+	"secs":       intervalDtkSecond,
+	"second":     intervalDtkSecond,
+	"seconds":    intervalDtkSecond,
+	"m":          intervalDtkMinute,
+	"min":        intervalDtkMinute,
+	"mins":       intervalDtkMinute,
+	"minute":     intervalDtkMinute,
+	"minutes":    intervalDtkMinute,
+	"h":          intervalDtkHour,
+	"hr":         intervalDtkHour,
+	"hrs":        intervalDtkHour,
+	"hour":       intervalDtkHour,
+	"hours":      intervalDtkHour,
+	"d":          intervalDtkDay,
+	"day":        intervalDtkDay,
+	"days":       intervalDtkDay,
+	"w":          intervalDtkWeek,
+	"week":       intervalDtkWeek,
+	"weeks":      intervalDtkWeek,
+	"mon":        intervalDtkMonth,
+	"mons":       intervalDtkMonth,
+	"month":      intervalDtkMonth,
+	"months":     intervalDtkMonth,
+	"y":          intervalDtkYear,
+	"yr":         intervalDtkYear,
+	"yrs":        intervalDtkYear,
+	"year":       intervalDtkYear,
+	"years":      intervalDtkYear,
+	"dec":        intervalDtkDecade,
+	"decs":       intervalDtkDecade,
+	"decade":     intervalDtkDecade,
+	"decades":    intervalDtkDecade,
+	"c":          intervalDtkCentury,
+	"cent":       intervalDtkCentury,
+	"century":    intervalDtkCentury,
+	"centuries":  intervalDtkCentury,
+	"mil":        intervalDtkMillennium,
+	"mils":       intervalDtkMillennium,
+	"millennium": intervalDtkMillennium,
+	"millennia":  intervalDtkMillennium,
+	"ago":        intervalDtkAgo,
+}
+
+// intervalField is a field of interval text representation with its byte offset in the whole string.
+type intervalField struct {
+	kind   int
+	s      string
+	offset int
+}
+
+func isDigit(c byte) bool  { return c >= '0' && c <= '9' }
+func isLetter(c byte) bool { return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' }
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\v' || c == '\f'
+}
+
+// splitIntervalFields splits interval text representation into fields in the same way as PostgreSQL ParseDateTime does.
+// Punctuation (such as "@" or ",") which is not a part of field is used as delimiter.
+func splitIntervalFields(s string) ([]intervalField, error) {
+	skip := func(i int, f func(byte) bool) int {
+		for i < len(s) && f(s[i]) {
+			i++
+		}
+		return i
+	}
+
+	var fields []intervalField
+	for i := 0; i < len(s); {
+		c := s[i]
+		start := i
+		switch {
+		case isSpace(c):
+			i++
+		case isDigit(c) || c == '.':
+			kind := intervalFieldNumber
+			i = skip(i+1, isDigit)
+			if c != '.' && i < len(s) {
+				switch delim := s[i]; delim {
+				case ':':
+					kind = intervalFieldTime
+					i = skip(i, func(c byte) bool { return isDigit(c) || c == ':' || c == '.' })
+				case '-', '/', '.':
+					i++
+					if i < len(s) && isDigit(s[i]) {
+						if delim != '.' {
+							kind = intervalFieldDate
+						}
+						i = skip(i, isDigit)
+						if i < len(s) && s[i] == delim {
+							kind = intervalFieldDate
+							i = skip(i, func(c byte) bool { return isDigit(c) || c == delim })
+						}
+					} else if delim != '.' {
+						kind = intervalFieldDate
+						i = skip(i, func(c byte) bool { return isDigit(c) || isLetter(c) || c == delim })
+					}
+				}
+			}
+			fields = append(fields, intervalField{kind, s[start:i], start})
+		case isLetter(c):
+			i = skip(i, isLetter)
+			fields = append(fields, intervalField{intervalFieldString, strings.ToLower(s[start:i]), start})
+		case c == '+' || c == '-':
+			i = skip(i+1, isSpace)
+			if i >= len(s) || !(isDigit(s[i]) || s[i] == '.') {
+				return nil, errIntervalParse(s, start, "sign "+strconv.Quote(string(c))+" is not followed by number")
+			}
+			from := i
+			i = skip(i, func(c byte) bool { return isDigit(c) || c == ':' || c == '.' || c == '-' })
+			fields = append(fields, intervalField{intervalFieldSigned, string(c) + s[from:i], start})
+		case c < 0x80 && strings.IndexByte("!\"#$%&'()*,/;<=>?@[\\]^_`{|}~", c) >= 0:
+			i++
+		default:
+			r, _ := utf8.DecodeRuneInString(s[i:])
+			return nil, errIntervalParse(s, start, "unexpected character "+strconv.QuoteRune(r))
+		}
+	}
+	return fields, nil
+}
+
+// decodeIntervalTime decodes unsigned time field "hh:mm[:ss[.fff]]" or "mm:ss.fff" into seconds part of b.
+// If negative is true then time is negated.
+// As PostgreSQL does, time field replaces previously decoded seconds part.
+// It returns description of the problem or empty string on success.
+func decodeIntervalTime(b *intervalBuilder, s string, negative bool) (reason string) {
+	const invalid = "invalid time "
+	h, rest, ok := parseIntervalNumber(s)
+	if !ok || h.frac != nil || s[0] == '+' || s[0] == '-' || rest == "" || rest[0] != ':' {
+		return invalid
+	}
+
+	var m, sec intervalNumber
+	m, rest, ok = parseIntervalNumber(rest[1:])
+	if !ok || rest != "" && rest[0] != ':' {
+		return invalid
+	}
+	if m.frac != nil { // mm:ss.fff
+		if rest != "" {
+			return invalid
+		}
+		h, m, sec = intervalNumber{}, h, m
+	} else if rest != "" {
+		if sec, rest, ok = parseIntervalNumber(rest[1:]); !ok || rest != "" {
+			return invalid
+		}
+	}
+	if h.ipart < 0 || m.ipart < 0 || m.ipart >= timeh.MinsInHour || m.frac != nil || sec.ipart < 0 || sec.ipart > timeh.SecsInMin {
+		return "out of range time "
+	}
+
+	tb := intervalBuilder{i: NewInterval(b.i.precision), negative: negative != b.negative}
+	tb.addSeconds(h.ipart, timeh.SecsInHour)
+	tb.addSeconds(m.ipart, timeh.SecsInMin)
+	tb.addSeconds(sec.ipart, 1)
+	tb.addFracSeconds(sec.frac, 1)
+	if tb.overflow {
+		return "out of range time "
+	}
+	b.i.SomeSeconds = tb.i.SomeSeconds
+	b.inexact = b.inexact || tb.inexact
+	b.digits = mathh.Max2Uint8(b.digits, tb.digits)
+	return ""
+}
+
+// addIntervalSubSeconds adds n/div seconds to b, n is rounded to the precision of b.
+func addIntervalSubSeconds(b *intervalBuilder, n intervalNumber, div int64) {
+	r := new(big.Rat).SetInt64(n.ipart)
+	if n.frac != nil {
+		r.Add(r, n.frac)
+	}
+	b.addFracSeconds(r.Quo(r, new(big.Rat).SetInt64(div)), 1)
+}
+
+// decodeInterval parses interval in PostgreSQL input syntax (any PostgreSQL output format except ISO 8601 is also valid input).
+// It is a port of PostgreSQL DecodeInterval.
+// If sqlStandard is true then leading minus applies to all fields if there are no other explicit signs (as PostgreSQL does if IntervalStyle is sql_standard).
+// Mode configures parsing of fraction of second, it may be nil.
+func decodeInterval(s string, p uint8, sqlStandard bool, mode *intervalParseMode) (Interval, error) {
+	fields, err := splitIntervalFields(s)
+	if err != nil {
+		return Interval{}, err
+	}
+	if len(fields) == 0 {
+		return Interval{}, errIntervalParse(s, len(s), "empty interval")
+	}
+
+	forceNegative := false
+	if sqlStandard && fields[0].s[0] == '-' {
+		forceNegative = true
+		for _, f := range fields[1:] {
+			if f.s[0] == '-' || f.s[0] == '+' {
+				forceNegative = false
+				break
+			}
+		}
+	}
+
+	b := newIntervalBuilder(p)
+	b.mode = mode
+	unit := intervalDtkNone
+	var unitField intervalField // Unit field which is waiting for its number
+	parsingUnitVal := false
+	var fmask uint
+
+	// Fields are processed from right to left because unit follows number
+	for i := len(fields) - 1; i >= 0; i-- {
+		f := fields[i]
+		var tmask uint
+
+		if f.kind == intervalFieldTime || f.kind == intervalFieldSigned && strings.IndexByte(f.s, ':') >= 0 {
+			var reason string
+			if f.kind == intervalFieldTime {
+				reason = decodeIntervalTime(&b, f.s, forceNegative)
+			} else {
+				reason = decodeIntervalTime(&b, f.s[1:], f.s[0] == '-' || forceNegative)
+			}
+			if reason != "" {
+				return Interval{}, errIntervalParse(s, f.offset, reason+strconv.Quote(f.s))
+			}
+			tmask = intervalTimeMask
+			unit = intervalDtkDay
+			parsingUnitVal = false
+		} else if f.kind == intervalFieldString {
+			if parsingUnitVal {
+				return Interval{}, errIntervalParse(s, unitField.offset, "missing number before unit "+strconv.Quote(unitField.s))
+			}
+			name := f.s
+			if len(name) > intervalUnitMaxLen {
+				name = name[:intervalUnitMaxLen]
+			}
+			u, ok := intervalUnits[name]
+			if !ok {
+				return Interval{}, errIntervalParse(s, f.offset, "unknown unit "+strconv.Quote(f.s))
+			}
+			if u == intervalDtkAgo {
+				if i != len(fields)-1 {
+					return Interval{}, errIntervalParse(s, f.offset, `"ago" must be the last word`)
+				}
+				b.negative = true
+			} else {
+				parsingUnitVal = true
+				unitField = f
+			}
+			unit = u
+		} else {
+			if unit == intervalDtkNone {
+				unit = intervalDtkSecond
+			}
+
+			n, rest, ok := parseIntervalNumber(f.s)
+			if !ok {
+				if strings.IndexAny(f.s, "0123456789") >= 0 {
+					return Interval{}, errIntervalParse(s, f.offset, "out of range number "+strconv.Quote(f.s))
+				}
+				return Interval{}, errIntervalParse(s, f.offset, "invalid number "+strconv.Quote(f.s))
+			}
+			if rest != "" {
+				// SQL "years-months" syntax
+				if rest[0] != '-' || n.frac != nil || strings.IndexByte(f.s, '.') >= 0 {
+					return Interval{}, errIntervalParse(s, f.offset, "invalid number "+strconv.Quote(f.s))
+				}
+				var m intervalNumber
+				if m, rest, ok = parseIntervalNumber(rest[1:]); !ok || rest != "" || m.frac != nil || m.ipart < 0 {
+					return Interval{}, errIntervalParse(s, f.offset, "invalid year-month "+strconv.Quote(f.s))
+				}
+				if m.ipart >= timeh.MonthsInYear {
+					return Interval{}, errIntervalParse(s, f.offset, "out of range year-month "+strconv.Quote(f.s))
+				}
+				if f.s[0] == '-' {
+					m.ipart = -m.ipart
+				}
+				if n.ipart, ok = mulInt64(n.ipart, timeh.MonthsInYear); ok {
+					n.ipart, ok = addInt64(n.ipart, m.ipart)
+				}
+				if !ok {
+					return Interval{}, errIntervalParse(s, f.offset, "out of range year-month "+strconv.Quote(f.s))
+				}
+				unit = intervalDtkMonth
+			}
+
+			if forceNegative {
+				if n.ipart > 0 {
+					n.ipart = -n.ipart
+				}
+				if n.frac != nil && n.frac.Sign() > 0 {
+					n.frac.Neg(n.frac)
+				}
+			}
+
+			tmask = 1 << uint(unit)
+			switch unit {
+			case intervalDtkMicrosecond:
+				addIntervalSubSeconds(&b, n, 1e6)
+			case intervalDtkMillisecond:
+				addIntervalSubSeconds(&b, n, 1e3)
+			case intervalDtkSecond:
+				b.addSeconds(n.ipart, 1)
+				b.addFracSeconds(n.frac, 1)
+				if n.frac != nil {
+					tmask = intervalAllSecsMask
+				}
+			case intervalDtkMinute:
+				b.addSeconds(n.ipart, timeh.SecsInMin)
+				b.addFracSeconds(n.frac, timeh.SecsInMin)
+			case intervalDtkHour:
+				b.addSeconds(n.ipart, timeh.SecsInHour)
+				b.addFracSeconds(n.frac, timeh.SecsInHour)
+				unit = intervalDtkDay
+			case intervalDtkDay:
+				b.addDays(n.ipart, 1)
+				b.addFracSeconds(n.frac, timeh.SecsInDay)
+			case intervalDtkWeek:
+				b.addDays(n.ipart, 7)
+				b.addFracDays(n.frac, 7)
+			case intervalDtkMonth:
+				b.addMonths(n.ipart, 1)
+				b.addFracDays(n.frac, timeh.DaysInMonth)
+			case intervalDtkYear:
+				b.addMonths(n.ipart, timeh.MonthsInYear)
+				b.addFracMonths(n.frac, timeh.MonthsInYear)
+			case intervalDtkDecade:
+				b.addMonths(n.ipart, 10*timeh.MonthsInYear)
+				b.addFracMonths(n.frac, 10*timeh.MonthsInYear)
+			case intervalDtkCentury:
+				b.addMonths(n.ipart, 100*timeh.MonthsInYear)
+				b.addFracMonths(n.frac, 100*timeh.MonthsInYear)
+			case intervalDtkMillennium:
+				b.addMonths(n.ipart, 1000*timeh.MonthsInYear)
+				b.addFracMonths(n.frac, 1000*timeh.MonthsInYear)
+			default: // number right before "ago"
+				return Interval{}, errIntervalParse(s, f.offset, "missing unit after number "+strconv.Quote(f.s))
+			}
+			if b.overflow {
+				return Interval{}, errIntervalParse(s, f.offset, "out of range field "+strconv.Quote(f.s))
+			}
+			parsingUnitVal = false
+		}
+
+		if b.lostFraction() {
+			return Interval{}, errIntervalParse(s, f.offset, "fraction beyond precision in field "+strconv.Quote(f.s))
+		}
+		if tmask&fmask != 0 {
+			return Interval{}, errIntervalParse(s, f.offset, "duplicate field "+strconv.Quote(f.s))
+		}
+		fmask |= tmask
+	}
+
+	if parsingUnitVal {
+		return Interval{}, errIntervalParse(s, unitField.offset, "missing number before unit "+strconv.Quote(unitField.s))
+	}
+	if fmask == 0 {
+		return Interval{}, errIntervalParse(s, len(s), "missing interval fields")
+	}
+
+	return b.result()
+}
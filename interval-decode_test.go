@@ -0,0 +1,137 @@
+package pgtypes
+
+import (
+	"testing"
+)
+
+func TestParseInterval_Syntax(t *testing.T) {
+	type testElement struct {
+		s   string
+		i   Interval
+		err bool
+	}
+
+	const p = IntervalMicrosecondPrecision
+	const sec = 1e6
+	test := []testElement{
+		// Units and abbreviations
+		{"1 hr 30 min", Interval{0, 0, 5400 * sec, p}, false},
+		{"1h30m", Interval{0, 0, 5400 * sec, p}, false},
+		{"1 HR 30 MIN", Interval{0, 0, 5400 * sec, p}, false},
+		{"1 yr 2 mons 3 d 4 hrs 5 mins 6 s", Interval{14, 3, 14706 * sec, p}, false},
+		{"1 year 2 months 3 days 4 hours 5 minutes 6 seconds", Interval{14, 3, 14706 * sec, p}, false},
+		{"@ 1 year 2 mons", Interval{14, 0, 0, p}, false},
+		{"1day", Interval{0, 1, 0, p}, false},
+		{"2 weeks", Interval{0, 14, 0, p}, false},
+		{"1 w 1 d", Interval{0, 8, 0, p}, false},
+		{"3 ms", Interval{0, 0, 3000, p}, false},
+		{"2 milliseconds", Interval{0, 0, 2000, p}, false},
+		{"5 us", Interval{0, 0, 5, p}, false},
+		{"5 microseconds", Interval{0, 0, 5, p}, false},
+		{"1 sec 500 msec 7 usec", Interval{0, 0, 1500007, p}, false},
+		{"1 decade", Interval{120, 0, 0, p}, false},
+		{"2 centuries", Interval{2400, 0, 0, p}, false},
+		{"1 c 1 mil", Interval{13200, 0, 0, p}, false},
+		{"1 millennium", Interval{12000, 0, 0, p}, false},
+		{"1 2 hours", Interval{0, 1, 7200 * sec, p}, false},
+		{"1-2", Interval{14, 0, 0, p}, false},
+		{"-1-2", Interval{-14, 0, 0, p}, false},
+		{"1 day 02:03", Interval{0, 1, 7380 * sec, p}, false},
+		{"10", Interval{0, 0, 10 * sec, p}, false},
+		// Fractions
+		{"1.5 days", Interval{0, 1, 43200 * sec, p}, false},
+		{"1.5 weeks", Interval{0, 10, 43200 * sec, p}, false},
+		{"1.5 mons", Interval{1, 15, 0, p}, false},
+		{"0.05 mon", Interval{0, 1, 43200 * sec, p}, false},
+		{"1.25 years", Interval{15, 0, 0, p}, false},
+		{"1.04 years", Interval{12, 0, 0, p}, false},
+		{"1.5 decades", Interval{180, 0, 0, p}, false},
+		{"0.001 millennia", Interval{12, 0, 0, p}, false},
+		{"1.5 hours", Interval{0, 0, 5400 * sec, p}, false},
+		{"0.5 min", Interval{0, 0, 30 * sec, p}, false},
+		{"1.5 ms", Interval{0, 0, 1500, p}, false},
+		{"0.4 us", Interval{0, 0, 0, p}, false},
+		{"-1.5 days", Interval{0, -1, -43200 * sec, p}, false},
+		// Ago
+		{"1 day ago", Interval{0, -1, 0, p}, false},
+		{"1 year 2 mons ago", Interval{-14, 0, 0, p}, false},
+		{"-1 day +2 hours ago", Interval{0, 1, -7200 * sec, p}, false},
+		{"1 day 02:00 ago", Interval{0, -1, -7200 * sec, p}, false},
+		{"@ 1.5 days ago", Interval{0, -1, -43200 * sec, p}, false},
+		// ISO 8601
+		{"P1Y2M", Interval{14, 0, 0, p}, false},
+		{"P1DT1.5H", Interval{0, 1, 5400 * sec, p}, false},
+		// Errors
+		{"   ", Interval{}, true},
+		{"1 fortnight", Interval{}, true},
+		{"day", Interval{}, true},
+		{"1 day hour", Interval{}, true},
+		{"ago", Interval{}, true},
+		{"1 ago", Interval{}, true},
+		{"ago 1 day", Interval{}, true},
+		{"1 day ago ago", Interval{}, true},
+		{"1 day 1 day", Interval{}, true},
+		{"1 2 days", Interval{}, true},
+		{"1 hour 02:00", Interval{}, true},
+		{"02:00 10 secs", Interval{}, true},
+		{"1.5 s 500 ms", Interval{}, true},
+		{"1 day -", Interval{}, true},
+		{"1 день", Interval{}, true},
+		{"1-12", Interval{}, true},
+		{"1 day 25:60", Interval{}, true},
+		{"P1X", Interval{}, true},
+		// Overflow
+		{"2147483648 days", Interval{}, true},
+		{"178956971 years", Interval{}, true},
+		{"214748365 decades", Interval{}, true},
+		{"99999999999999999999 days", Interval{}, true},
+		{"2562047789 hours", Interval{}, true},
+		{"9223372036854775807 us", Interval{0, 0, 9223372036854775807, p}, false},
+		{"9223372036854775807 us 1 sec", Interval{}, true},
+	}
+
+	for _, v := range test {
+		i, err := ParseInterval(v.s, p)
+		if (err != nil) != v.err {
+			t.Errorf("%v: expect error %v, got %v", v.s, v.err, err)
+		}
+		if !v.err && err == nil && i != v.i {
+			t.Errorf("%v: expect %#v, got %#v", v.s, v.i, i)
+		}
+	}
+}
+
+func TestParseInterval_Error(t *testing.T) {
+	type testElement struct {
+		s      string
+		offset int
+		reason string
+	}
+
+	test := []testElement{
+		{"1 dya", 2, `unknown unit "dya"`},
+		{"1 day hour", 6, `missing number before unit "hour"`},
+		{"hour", 0, `missing number before unit "hour"`},
+		{"1 day 2 days", 0, `duplicate field "1"`},
+		{"1 ago 2 days", 2, `"ago" must be the last word`},
+		{"1 ago", 0, `missing unit after number "1"`},
+		{"  ", 2, "empty interval"},
+		{"ago", 3, "missing interval fields"},
+		{"1 day 25:61", 6, `out of range time "25:61"`},
+		{"1 day 1:2:3:4", 6, `invalid time "1:2:3:4"`},
+		{"1 дн", 2, `unexpected character 'д'`},
+		{"1 day - x", 6, `sign "-" is not followed by number`},
+		{"1 99999999999999999999 days", 2, `out of range number "99999999999999999999"`},
+		{"2147483648 days", 0, `out of range field "2147483648"`},
+		{"1-13", 0, `out of range year-month "1-13"`},
+		{"P1X", 2, `unknown date designator "X"`},
+		{"PT1H2", 4, "unexpected alternative format"},
+	}
+
+	for _, v := range test {
+		_, err := ParseInterval(v.s, IntervalMicrosecondPrecision)
+		if e, ok := err.(*ParseError); !ok || e.Type != "interval" || e.Str != v.s || e.Offset != v.offset || e.Reason != v.reason {
+			t.Errorf("%v: expect error at %v with reason %v, got %#v", v.s, v.offset, v.reason, err)
+		}
+	}
+}
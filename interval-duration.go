@@ -0,0 +1,64 @@
+package pgtypes
+
+import (
+	"errors"
+	"github.com/apaxa-go/helper/mathh"
+	"github.com/apaxa-go/helper/timeh"
+	"math/big"
+	"time"
+)
+
+var (
+	errIntervalDurationRange     = errors.New("interval is out of time.Duration range")
+	errIntervalDurationNotStrict = errors.New("interval has non-zero months or days part")
+	errIntervalDurationPrecision = errors.New("interval has non-zero sub-nanosecond part")
+)
+
+// DurationOptions configures conversion of Interval to time.Duration by ToDuration.
+type DurationOptions struct {
+	// Strict requires months and days parts of interval to be zero.
+	Strict bool
+	// DaysInMonth is a number of days in month used to convert months part. Zero means 30 (as in PostgreSQL).
+	DaysInMonth uint8
+	// MinutesInDay is a number of minutes in day used to convert months and days parts. Zero means 1440.
+	MinutesInDay uint32
+	// Truncate allows to truncate sub-nanosecond part of interval (toward zero) instead of returning error.
+	Truncate bool
+}
+
+// ToDuration converts Interval to time.Duration.
+// Unlike Duration it calculates result exactly and returns error if result does not fit time.Duration,
+// if months or days part is non-zero and opts.Strict is set, or if sub-nanosecond part is non-zero and opts.Truncate is not set.
+// For any time.Duration d, FromDuration(d).ToDuration(DurationOptions{Strict: true}) returns d without error.
+func (i Interval) ToDuration(opts DurationOptions) (time.Duration, error) {
+	if opts.Strict && (i.Months != 0 || i.Days != 0) {
+		return 0, errIntervalDurationNotStrict
+	}
+	daysInMonth, minutesInDay := int64(opts.DaysInMonth), int64(opts.MinutesInDay)
+	if daysInMonth == 0 {
+		daysInMonth = timeh.DaysInMonth
+	}
+	if minutesInDay == 0 {
+		minutesInDay = timeh.SecsInDay / timeh.SecsInMin
+	}
+
+	v := big.NewInt(int64(i.Months)*daysInMonth + int64(i.Days))
+	v.Mul(v, big.NewInt(minutesInDay*timeh.SecsInMin))
+	v.Mul(v, big.NewInt(mathh.PowInt64(10, int64(i.precision))))
+	v.Add(v, big.NewInt(i.SomeSeconds))
+	if i.precision <= IntervalNanosecondPrecision {
+		v.Mul(v, big.NewInt(mathh.PowInt64(10, int64(IntervalNanosecondPrecision-i.precision))))
+	} else {
+		var rem big.Int
+		v.QuoRem(v, big.NewInt(mathh.PowInt64(10, int64(i.precision-IntervalNanosecondPrecision))), &rem)
+		if rem.Sign() != 0 && !opts.Truncate {
+			return 0, errIntervalDurationPrecision
+		}
+	}
+
+	d, ok := bigInt64(v)
+	if !ok {
+		return 0, errIntervalDurationRange
+	}
+	return time.Duration(d), nil
+}
@@ -0,0 +1,58 @@
+package pgtypes
+
+import (
+	"github.com/apaxa-go/helper/mathh"
+	"testing"
+	"time"
+)
+
+func TestInterval_ToDuration(t *testing.T) {
+	type testElement struct {
+		i    Interval
+		opts DurationOptions
+		d    time.Duration
+		err  bool
+	}
+
+	const p = IntervalMicrosecondPrecision
+	test := []testElement{
+		{Interval{0, 0, 0, p}, DurationOptions{Strict: true}, 0, false},
+		{Interval{0, 0, 1500000, p}, DurationOptions{Strict: true}, 1500 * time.Millisecond, false},
+		{Interval{0, 0, -90, IntervalSecondPrecision}, DurationOptions{}, -90 * time.Second, false},
+		{Interval{0, 1, 0, p}, DurationOptions{}, 24 * time.Hour, false},
+		{Interval{1, 1, 0, p}, DurationOptions{}, 31 * 24 * time.Hour, false},
+		{Interval{1, 1, 0, p}, DurationOptions{DaysInMonth: 31, MinutesInDay: 60}, 32 * time.Hour, false},
+		{Interval{1, -30, 3600e6, p}, DurationOptions{}, time.Hour, false},
+		{Interval{0, 1, 0, p}, DurationOptions{Strict: true}, 0, true},
+		{Interval{1, 0, 0, p}, DurationOptions{Strict: true}, 0, true},
+		{Interval{0, 0, 1500, IntervalPicosecondPrecision}, DurationOptions{}, 0, true},
+		{Interval{0, 0, 1500, IntervalPicosecondPrecision}, DurationOptions{Truncate: true}, 1, false},
+		{Interval{0, 0, -1500, IntervalPicosecondPrecision}, DurationOptions{Truncate: true}, -1, false},
+		{Interval{0, 0, 3000, IntervalPicosecondPrecision}, DurationOptions{Strict: true}, 3, false},
+		{Interval{0, 0, mathh.MaxInt64, IntervalPicosecondPrecision}, DurationOptions{Truncate: true}, mathh.MaxInt64 / 1000, false},
+		{Interval{0, 0, mathh.MaxInt64, IntervalNanosecondPrecision}, DurationOptions{}, mathh.MaxInt64, false},
+		{Interval{0, 0, mathh.MinInt64, IntervalNanosecondPrecision}, DurationOptions{}, mathh.MinInt64, false},
+		{Interval{0, 0, mathh.MaxInt64/1000 + 1, IntervalMicrosecondPrecision}, DurationOptions{}, 0, true},
+		{Interval{0, 0, mathh.MaxInt64, IntervalSecondPrecision}, DurationOptions{}, 0, true},
+		{Interval{0, 106752, 0, p}, DurationOptions{}, 0, true},
+		{Interval{1, 0, 0, IntervalPicosecondPrecision}, DurationOptions{DaysInMonth: 255, MinutesInDay: mathh.MaxUint32}, 0, true},
+		{Interval{0, 1, mathh.MaxInt64 - 86400e9, IntervalNanosecondPrecision}, DurationOptions{}, mathh.MaxInt64, false},
+		{Interval{0, 1, mathh.MaxInt64 - 86400e9 + 1, IntervalNanosecondPrecision}, DurationOptions{}, 0, true},
+		{Interval{0, -1, mathh.MinInt64 + 86400e9, IntervalNanosecondPrecision}, DurationOptions{}, mathh.MinInt64, false},
+	}
+
+	for _, v := range test {
+		d, err := v.i.ToDuration(v.opts)
+		if (err != nil) != v.err || !v.err && d != v.d {
+			t.Errorf("%v,%+v: expect %v %v, got %v %v", v.i, v.opts, v.d, v.err, d, err)
+		}
+	}
+}
+
+func TestFromDuration_ToDuration(t *testing.T) {
+	for _, d := range []time.Duration{0, 1, -1, time.Hour, 1500 * time.Millisecond, mathh.MaxInt64, mathh.MinInt64} {
+		if r, err := FromDuration(d).ToDuration(DurationOptions{Strict: true}); err != nil || r != d {
+			t.Errorf("%v: expect %v, got %v %v", d, d, r, err)
+		}
+	}
+}
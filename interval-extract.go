@@ -0,0 +1,63 @@
+package pgtypes
+
+import (
+	"github.com/apaxa-go/helper/mathh"
+	"github.com/apaxa-go/helper/timeh"
+	"math/big"
+)
+
+// Extract returns value of the given unit (field) of interval in the same way as PostgreSQL 14 EXTRACT (and date_part) does.
+// Each unit is calculated from the corresponding part of interval and has the same sign as that part:
+//
+//	IntervalUnitYear, IntervalUnitDecade, IntervalUnitCentury, IntervalUnitMillennium and IntervalUnitQuarter are calculated from months part (quarter is months%12/3+1);
+//	IntervalUnitMonth is months part modulo 12;
+//	IntervalUnitDay is days part;
+//	IntervalUnitHour and IntervalUnitMinute are whole hours and minutes of seconds part;
+//	IntervalUnitSecond, IntervalUnitMillisecond and IntervalUnitMicrosecond are seconds (including fraction) of seconds part after subtracting whole minutes, in the given units;
+//	IntervalUnitEpoch is total number of seconds, with 365.25 days per year, 30 days per month and 24 hours per day.
+//
+// Examples: for "-1 year -2 mons 3 days -04:05:06.789" year is -1, month is -2, hour is -4 and second is -6.789.
+func (i Interval) Extract(unit IntervalUnit) (*Numeric, error) {
+	negative, h, m, s, f := intervalSecondsParts(i.SomeSeconds, i.precision)
+	p := int(i.precision)
+	sign := int64(1)
+	if negative {
+		sign = -1
+	}
+	// seconds with fraction in units of precision
+	secs := new(big.Int).SetUint64(s*uint64(mathh.PowInt64(10, int64(p))) + f)
+	if negative {
+		secs.Neg(secs)
+	}
+
+	switch unit {
+	case IntervalUnitMicrosecond:
+		return NewNumeric().setScaled(secs.Mul(secs, big.NewInt(1e6)), p), nil
+	case IntervalUnitMillisecond:
+		return NewNumeric().setScaled(secs.Mul(secs, big.NewInt(1e3)), p), nil
+	case IntervalUnitSecond:
+		return NewNumeric().setScaled(secs, p), nil
+	case IntervalUnitMinute:
+		return NewInt64(sign * int64(m)), nil
+	case IntervalUnitHour:
+		return NewInt64(sign * int64(h)), nil
+	case IntervalUnitDay:
+		return NewInt32(i.Days), nil
+	case IntervalUnitMonth:
+		return NewInt32(i.Months % timeh.MonthsInYear), nil
+	case IntervalUnitQuarter:
+		return NewInt32(i.Months%timeh.MonthsInYear/3 + 1), nil
+	case IntervalUnitYear:
+		return NewInt32(i.Months / timeh.MonthsInYear), nil
+	case IntervalUnitDecade:
+		return NewInt32(i.Months / timeh.MonthsInYear / 10), nil
+	case IntervalUnitCentury:
+		return NewInt32(i.Months / timeh.MonthsInYear / 100), nil
+	case IntervalUnitMillennium:
+		return NewInt32(i.Months / timeh.MonthsInYear / 1000), nil
+	case IntervalUnitEpoch:
+		return i.Seconds(), nil
+	default:
+		return nil, errIntervalUnitNotSupported(unit)
+	}
+}
@@ -0,0 +1,64 @@
+package pgtypes
+
+import (
+	"github.com/apaxa-go/helper/mathh"
+	"testing"
+)
+
+func TestInterval_Extract(t *testing.T) {
+	type testElement struct {
+		i    Interval
+		unit IntervalUnit
+		r    string
+	}
+
+	i1 := Interval{-14, 3, -14706789 * 1e3, IntervalMicrosecondPrecision} // -1 year -2 mons 3 days -04:05:06.789
+	i2 := Interval{30000, 1, 90000 * 1e6, IntervalMicrosecondPrecision}   // 2500 years 1 day 25:00:00
+	i3 := Interval{0, 0, 1500, IntervalNanosecondPrecision}               // 00:00:00.0000015
+	// Results are the same as in PostgreSQL
+	test := []testElement{
+		{i1, IntervalUnitMicrosecond, "-6789000"},
+		{i1, IntervalUnitMillisecond, "-6789"},
+		{i1, IntervalUnitSecond, "-6.789"},
+		{i1, IntervalUnitMinute, "-5"},
+		{i1, IntervalUnitHour, "-4"},
+		{i1, IntervalUnitDay, "3"},
+		{i1, IntervalUnitMonth, "-2"},
+		{i1, IntervalUnitQuarter, "1"},
+		{i1, IntervalUnitYear, "-1"},
+		{i1, IntervalUnitDecade, "0"},
+		{i1, IntervalUnitCentury, "0"},
+		{i1, IntervalUnitMillennium, "0"},
+		{i1, IntervalUnitEpoch, "-36497106.789"},
+		{i2, IntervalUnitSecond, "0"},
+		{i2, IntervalUnitHour, "25"},
+		{i2, IntervalUnitQuarter, "1"},
+		{i2, IntervalUnitYear, "2500"},
+		{i2, IntervalUnitDecade, "250"},
+		{i2, IntervalUnitCentury, "25"},
+		{i2, IntervalUnitMillennium, "2"},
+		{i2, IntervalUnitEpoch, "78894176400"},
+		{Interval{11, 0, 0, IntervalMicrosecondPrecision}, IntervalUnitQuarter, "4"},
+		{Interval{1, 0, 0, IntervalMicrosecondPrecision}, IntervalUnitEpoch, "2592000"},
+		{Interval{12, 0, 0, IntervalMicrosecondPrecision}, IntervalUnitEpoch, "31557600"},
+		{i3, IntervalUnitMicrosecond, "1.5"},
+		{i3, IntervalUnitMillisecond, "0.0015"},
+		{i3, IntervalUnitSecond, "0.0000015"},
+		{i3, IntervalUnitEpoch, "0.0000015"},
+		{Interval{0, 0, mathh.MinInt64, IntervalMicrosecondPrecision}, IntervalUnitHour, "-2562047788"},
+		{Interval{0, 0, mathh.MinInt64, IntervalMicrosecondPrecision}, IntervalUnitSecond, "-54.775808"},
+		{Interval{0, 0, mathh.MinInt64, IntervalMicrosecondPrecision}, IntervalUnitEpoch, "-9223372036854.775808"},
+		{Interval{mathh.MaxInt32, mathh.MaxInt32, mathh.MaxInt64, IntervalPicosecondPrecision}, IntervalUnitEpoch, "5832995090940172.036854775807"},
+	}
+
+	for _, v := range test {
+		r, err := v.i.Extract(v.unit)
+		if err != nil || r.String() != v.r {
+			t.Errorf("%v,%v: expect %v, got %v %v", v.i, v.unit, v.r, r, err)
+		}
+	}
+
+	if _, err := i1.Extract(IntervalUnit(100)); err == nil {
+		t.Error("expect error for unknown unit")
+	}
+}
@@ -0,0 +1,219 @@
+package pgtypes
+
+import (
+	"github.com/apaxa-go/helper/strconvh"
+	"github.com/apaxa-go/helper/timeh"
+)
+
+// IntervalHumanStyle defines output style of Humanize.
+type IntervalHumanStyle uint8
+
+// Possible humanized interval styles.
+const (
+	IntervalHumanVerbose IntervalHumanStyle = iota // "1 year, 2 months and 3 days"
+	IntervalHumanCompact                           // "1y2mo3d"
+)
+
+// IntervalLanguage is a language table used by Humanize.
+// Other languages can be supported by filling this table.
+type IntervalLanguage struct {
+	// Forms contains names of units for verbose style, one entry for each plural form.
+	Forms map[IntervalUnit][]string
+	// Plural returns index of plural form in Forms for the given (absolute) number.
+	Plural func(n uint64) int
+	// Abbrs contains abbreviations of units for compact style.
+	Abbrs map[IntervalUnit]string
+	// Separator is placed between units in verbose style, except the last pair.
+	Separator string
+	// LastSeparator is placed between two last units in verbose style.
+	LastSeparator string
+	// About is a prefix of inexact result in verbose style.
+	About string
+	// AboutCompact is a prefix of inexact result in compact style.
+	AboutCompact string
+}
+
+// IntervalEnglish is the default language table for Humanize.
+var IntervalEnglish = IntervalLanguage{
+	Forms: map[IntervalUnit][]string{
+		IntervalUnitYear:        {"year", "years"},
+		IntervalUnitMonth:       {"month", "months"},
+		IntervalUnitDay:         {"day", "days"},
+		IntervalUnitHour:        {"hour", "hours"},
+		IntervalUnitMinute:      {"minute", "minutes"},
+		IntervalUnitSecond:      {"second", "seconds"},
+		IntervalUnitMillisecond: {"millisecond", "milliseconds"},
+		IntervalUnitMicrosecond: {"microsecond", "microseconds"},
+	},
+	Plural: func(n uint64) int {
+		if n == 1 {
+			return 0
+		}
+		return 1
+	},
+	Abbrs: map[IntervalUnit]string{
+		IntervalUnitYear:        "y",
+		IntervalUnitMonth:       "mo",
+		IntervalUnitDay:         "d",
+		IntervalUnitHour:        "h",
+		IntervalUnitMinute:      "m",
+		IntervalUnitSecond:      "s",
+		IntervalUnitMillisecond: "ms",
+		IntervalUnitMicrosecond: "us",
+	},
+	Separator:     ", ",
+	LastSeparator: " and ",
+	About:         "about ",
+	AboutCompact:  "~",
+}
+
+// IntervalHumanOptions configures Humanize.
+// Zero value means verbose English output of all units down to microseconds.
+type IntervalHumanOptions struct {
+	Style IntervalHumanStyle
+	// MaxUnits limits number of non-zero units in result (the largest ones are kept). Zero means no limit.
+	MaxUnits int
+	// SmallestUnit is the smallest unit in result. Quarter is treated as month and units greater than year are treated as year.
+	SmallestUnit IntervalUnit
+	// Round rounds the smallest unit in result to the nearest instead of truncating.
+	Round bool
+	// About adds prefix from language table if result is not exact because of MaxUnits, SmallestUnit or precision less than microsecond.
+	About bool
+	// Language is a language table. Nil means IntervalEnglish.
+	Language *IntervalLanguage
+}
+
+// intervalHumanUnits lists units used by Humanize from the largest to the smallest.
+var intervalHumanUnits = [...]IntervalUnit{
+	IntervalUnitYear,
+	IntervalUnitMonth,
+	IntervalUnitDay,
+	IntervalUnitHour,
+	IntervalUnitMinute,
+	IntervalUnitSecond,
+	IntervalUnitMillisecond,
+	IntervalUnitMicrosecond,
+}
+
+// humanParts returns values of intervalHumanUnits in i.
+// Hours are not justified to days and days are not justified to months.
+func (i Interval) humanParts() (r [len(intervalHumanUnits)]int64) {
+	negative, h, m, s, f := intervalSecondsParts(i.SomeSeconds, i.precision)
+	us := someSecondsChangePrecision(int64(f), i.precision, IntervalMicrosecondPrecision)
+	sign := int64(1)
+	if negative {
+		sign = -1
+	}
+	r[0], r[1], r[2] = int64(i.Months/timeh.MonthsInYear), int64(i.Months%timeh.MonthsInYear), int64(i.Days)
+	r[3], r[4], r[5], r[6], r[7] = sign*int64(h), sign*int64(m), sign*int64(s), sign*(us/1000), sign*(us%1000)
+	return
+}
+
+// humanUnitIndex returns index of unit u in intervalHumanUnits.
+func humanUnitIndex(u IntervalUnit) int {
+	if u == IntervalUnitQuarter {
+		u = IntervalUnitMonth
+	} else if u > IntervalUnitYear {
+		u = IntervalUnitYear
+	}
+	for k, v := range intervalHumanUnits {
+		if v == u {
+			return k
+		}
+	}
+	return len(intervalHumanUnits) - 1
+}
+
+// format returns n with name of unit u in the given style.
+func (l *IntervalLanguage) format(n int64, u IntervalUnit, style IntervalHumanStyle) string {
+	s := strconvh.FormatInt64(n)
+	if style == IntervalHumanCompact {
+		return s + l.Abbrs[u]
+	}
+	abs := uint64(n)
+	if n < 0 {
+		abs = uint64(-n)
+	}
+	forms := l.Forms[u]
+	if len(forms) == 0 {
+		return s + " " + u.String()
+	}
+	k := l.Plural(abs)
+	if k < 0 || k >= len(forms) {
+		k = len(forms) - 1
+	}
+	return s + " " + forms[k]
+}
+
+// Humanize returns human readable representation of i, such as "1 year, 2 months and 3 days" or "1y2mo3d".
+// Each part of interval is formatted separately (so "1 mon -1 day" is "1 month and -1 day"), hours are not justified to days and days are not justified to months.
+// Example: "1 day 04:40:00" with MaxUnits=2 is "1 day and 4 hours", and with Round and About it is "about 1 day and 5 hours".
+// Zero interval is "0 seconds" (or zero of SmallestUnit if it is greater than second).
+// It returns error if Round is set and rounded interval overflows.
+func (i Interval) Humanize(opts IntervalHumanOptions) (string, error) {
+	l := opts.Language
+	if l == nil {
+		l = &IntervalEnglish
+	}
+
+	// Find the smallest unit to show
+	cut := humanUnitIndex(opts.SmallestUnit)
+	parts := i.humanParts()
+	if opts.MaxUnits > 0 {
+		n := 0
+		for k := 0; k < cut; k++ {
+			if parts[k] != 0 {
+				n++
+				if n == opts.MaxUnits {
+					cut = k
+					break
+				}
+			}
+		}
+	}
+	unit := intervalHumanUnits[cut]
+
+	var r Interval
+	if opts.Round {
+		var err error
+		if r, err = i.Round(unit); err != nil {
+			return "", err
+		}
+	} else {
+		r, _ = i.Truncate(unit) // It never fails for units from intervalHumanUnits
+	}
+
+	parts = r.humanParts()
+	var strs []string
+	for k := 0; k <= cut; k++ {
+		if parts[k] != 0 {
+			strs = append(strs, l.format(parts[k], intervalHumanUnits[k], opts.Style))
+		}
+	}
+	if len(strs) == 0 {
+		if unit = IntervalUnitSecond; cut < humanUnitIndex(unit) {
+			unit = intervalHumanUnits[cut]
+		}
+		strs = append(strs, l.format(0, unit, opts.Style))
+	}
+
+	var s string
+	for k, v := range strs {
+		if k > 0 && opts.Style != IntervalHumanCompact {
+			if k == len(strs)-1 {
+				s += l.LastSeparator
+			} else {
+				s += l.Separator
+			}
+		}
+		s += v
+	}
+	if opts.About && r != i {
+		if opts.Style == IntervalHumanCompact {
+			s = l.AboutCompact + s
+		} else {
+			s = l.About + s
+		}
+	}
+	return s, nil
+}
@@ -0,0 +1,80 @@
+package pgtypes
+
+import (
+	"github.com/apaxa-go/helper/mathh"
+	"testing"
+)
+
+func TestInterval_Humanize(t *testing.T) {
+	type testElement struct {
+		i    Interval
+		opts IntervalHumanOptions
+		r    string
+		err  bool
+	}
+
+	const p = IntervalMicrosecondPrecision
+	const sec = 1e6
+	i1 := Interval{14, 3, (4*3600 + 5*60 + 6) * sec, p} // 1 year 2 mons 3 days 04:05:06
+	i2 := Interval{0, 1, (4*3600 + 40*60) * sec, p}     // 1 day 04:40:00
+	i3 := Interval{0, 0, 1001001, p}                    // 00:00:01.001001
+	russian := IntervalLanguage{
+		Forms: map[IntervalUnit][]string{
+			IntervalUnitDay:  {"день", "дня", "дней"},
+			IntervalUnitHour: {"час", "часа", "часов"},
+		},
+		Plural: func(n uint64) int {
+			switch {
+			case n%10 == 1 && n%100 != 11:
+				return 0
+			case n%10 >= 2 && n%10 <= 4 && (n%100 < 10 || n%100 >= 20):
+				return 1
+			default:
+				return 2
+			}
+		},
+		Abbrs:         map[IntervalUnit]string{IntervalUnitDay: "д", IntervalUnitHour: "ч"},
+		Separator:     ", ",
+		LastSeparator: " и ",
+		About:         "около ",
+		AboutCompact:  "~",
+	}
+	test := []testElement{
+		{i1, IntervalHumanOptions{}, "1 year, 2 months, 3 days, 4 hours, 5 minutes and 6 seconds", false},
+		{i1, IntervalHumanOptions{Style: IntervalHumanCompact}, "1y2mo3d4h5m6s", false},
+		{i1, IntervalHumanOptions{MaxUnits: 3}, "1 year, 2 months and 3 days", false},
+		{i1, IntervalHumanOptions{MaxUnits: 3, About: true}, "about 1 year, 2 months and 3 days", false},
+		{i1, IntervalHumanOptions{MaxUnits: 1, About: true, Style: IntervalHumanCompact}, "~1y", false},
+		{i1, IntervalHumanOptions{SmallestUnit: IntervalUnitMinute}, "1 year, 2 months, 3 days, 4 hours and 5 minutes", false},
+		{i1, IntervalHumanOptions{SmallestUnit: IntervalUnitQuarter}, "1 year and 2 months", false},
+		{i1, IntervalHumanOptions{SmallestUnit: IntervalUnitEpoch}, "1 year", false},
+		{i2, IntervalHumanOptions{MaxUnits: 2}, "1 day and 4 hours", false},
+		{i2, IntervalHumanOptions{MaxUnits: 2, Round: true, About: true}, "about 1 day and 5 hours", false},
+		{i2, IntervalHumanOptions{MaxUnits: 1, Round: true, About: true}, "about 1 day", false},
+		{i2, IntervalHumanOptions{Language: &russian}, "1 день, 4 часа и 40 minute", false},
+		{Interval{0, 22, 11 * 3600 * sec, p}, IntervalHumanOptions{Language: &russian}, "22 дня и 11 часов", false},
+		{Interval{0, 5, 21 * 3600 * sec, p}, IntervalHumanOptions{Language: &russian, Style: IntervalHumanCompact}, "5д21ч", false},
+		{i3, IntervalHumanOptions{}, "1 second, 1 millisecond and 1 microsecond", false},
+		{i3, IntervalHumanOptions{Style: IntervalHumanCompact}, "1s1ms1us", false},
+		{i3, IntervalHumanOptions{SmallestUnit: IntervalUnitSecond, About: true}, "about 1 second", false},
+		{Interval{0, 0, 4 * 3600 * sec, p}, IntervalHumanOptions{MaxUnits: 1, About: true}, "4 hours", false},
+		{Interval{0, 0, (3*3600 + 59*60 + 59) * sec, p}, IntervalHumanOptions{MaxUnits: 1, Round: true, About: true}, "about 4 hours", false},
+		{Interval{0, 0, 1500, IntervalNanosecondPrecision}, IntervalHumanOptions{About: true}, "about 1 microsecond", false},
+		{Interval{0, 0, 1000, IntervalNanosecondPrecision}, IntervalHumanOptions{About: true}, "1 microsecond", false},
+		{Interval{-1, 1, -sec, p}, IntervalHumanOptions{}, "-1 month, 1 day and -1 second", false},
+		{Interval{-13, -1, -sec, p}, IntervalHumanOptions{Style: IntervalHumanCompact}, "-1y-1mo-1d-1s", false},
+		{Interval{0, 0, 0, p}, IntervalHumanOptions{}, "0 seconds", false},
+		{Interval{0, 0, 0, p}, IntervalHumanOptions{Style: IntervalHumanCompact}, "0s", false},
+		{Interval{0, 0, 0, p}, IntervalHumanOptions{SmallestUnit: IntervalUnitDay}, "0 days", false},
+		{Interval{0, 0, 3600 * sec, p}, IntervalHumanOptions{SmallestUnit: IntervalUnitDay, About: true}, "about 0 days", false},
+		{Interval{0, 0, 999, p}, IntervalHumanOptions{SmallestUnit: IntervalUnitMillisecond}, "0 seconds", false},
+		{Interval{0, 0, mathh.MinInt64, IntervalSecondPrecision}, IntervalHumanOptions{MaxUnits: 1}, "-2562047788015215 hours", false},
+		{Interval{0, 0, mathh.MaxInt64, IntervalSecondPrecision}, IntervalHumanOptions{MaxUnits: 1, Round: true}, "", true},
+	}
+
+	for _, v := range test {
+		if r, err := v.i.Humanize(v.opts); (err != nil) != v.err || r != v.r {
+			t.Errorf("%v,%+v: expect %v %v, got %v %v", v.i, v.opts, v.r, v.err, r, err)
+		}
+	}
+}
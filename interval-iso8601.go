@@ -0,0 +1,285 @@
+package pgtypes
+
+import (
+	"github.com/apaxa-go/helper/strconvh"
+	"github.com/apaxa-go/helper/stringsh"
+	"github.com/apaxa-go/helper/timeh"
+	"strconv"
+)
+
+// iso8601IntegerWidth returns number of digits in the integer part of number at the beginning of s (leading minus is skipped).
+func iso8601IntegerWidth(s string) (w int) {
+	if len(s) > 0 && s[0] == '-' {
+		s = s[1:]
+	}
+	for w < len(s) && s[w] >= '0' && s[w] <= '9' {
+		w++
+	}
+	return
+}
+
+// ParseIntervalISO8601 parses incoming string in ISO 8601 format and extract interval with requested precision p.
+// Both format with designators and alternative format are supported (as in PostgreSQL).
+// Each number may have fractional part, fractional part cascades into smaller fields in the same way as PostgreSQL does.
+// Examples:
+//
+//	P1Y2M3DT4H5M6.789S
+//	P-1Y2M-3DT-4H
+//	P1.5W
+//	PT0S
+//	P0001-02-03T04:05:06.789
+//	P00010203T040506
+func ParseIntervalISO8601(s string, p uint8) (Interval, error) {
+	return parseIntervalISO8601(s, p, nil)
+}
+
+// parseIntervalISO8601 parses interval in ISO 8601 format.
+// Mode configures parsing of fraction of second, it may be nil.
+func parseIntervalISO8601(s string, p uint8, mode *intervalParseMode) (Interval, error) {
+	if s == "" || s[0] != 'P' {
+		return Interval{}, errIntervalParse(s, 0, `missing "P" designator`)
+	}
+	if len(s) == 1 {
+		return Interval{}, errIntervalParse(s, 1, "missing interval fields")
+	}
+	const inexact = "fraction beyond precision"
+	fail := func(rest, reason string) (Interval, error) {
+		return Interval{}, errIntervalParse(s, len(s)-len(rest), reason)
+	}
+
+	b := newIntervalBuilder(p)
+	b.mode = mode
+	result := func(field string) (Interval, error) {
+		if b.lostFraction() {
+			return fail(field, inexact)
+		}
+		return b.result()
+	}
+	str := s[1:]
+	datePart := true
+	haveField := false
+
+	for str != "" {
+		if str[0] == 'T' {
+			datePart = false
+			haveField = false
+			str = str[1:]
+			continue
+		}
+
+		fieldStart := str
+		n, rest, ok := parseIntervalNumber(str)
+		if !ok {
+			return fail(fieldStart, "invalid number")
+		}
+		str = rest
+		var unit byte
+		if str != "" {
+			unit = str[0]
+			str = str[1:]
+		}
+
+		if datePart {
+			switch unit {
+			case 'Y':
+				b.addMonths(n.ipart, timeh.MonthsInYear)
+				b.addFracMonths(n.frac, timeh.MonthsInYear)
+			case 'M':
+				b.addMonths(n.ipart, 1)
+				b.addFracDays(n.frac, timeh.DaysInMonth)
+			case 'W':
+				b.addDays(n.ipart, 7)
+				b.addFracDays(n.frac, 7)
+			case 'D':
+				b.addDays(n.ipart, 1)
+				b.addFracSeconds(n.frac, timeh.SecsInDay)
+			case 'T', 0, '-':
+				// Alternative format, basic: YYYYMMDD
+				if unit != '-' && iso8601IntegerWidth(fieldStart) == 8 && !haveField {
+					b.addMonths(n.ipart/10000, timeh.MonthsInYear)
+					b.addMonths(n.ipart/100%100, 1)
+					b.addDays(n.ipart%100, 1)
+					b.addFracSeconds(n.frac, timeh.SecsInDay)
+					datePart = false
+					break
+				}
+
+				// Alternative format, extended: YYYY-MM-DD
+				if haveField {
+					return fail(fieldStart, "unexpected alternative format")
+				}
+				b.addMonths(n.ipart, timeh.MonthsInYear)
+				b.addFracMonths(n.frac, timeh.MonthsInYear)
+				if unit == 'T' || unit == 0 {
+					datePart = false
+					break
+				}
+
+				// month
+				if n, str, ok = parseIntervalNumber(str); !ok {
+					return fail(str, "invalid month")
+				}
+				b.addMonths(n.ipart, 1)
+				b.addFracDays(n.frac, timeh.DaysInMonth)
+				if str == "" || str[0] == 'T' {
+					datePart = false
+					break
+				}
+				if str[0] != '-' {
+					return fail(str, "unexpected character "+strconv.Quote(str[:1]))
+				}
+
+				// day
+				if n, str, ok = parseIntervalNumber(str[1:]); !ok {
+					return fail(str, "invalid day")
+				}
+				b.addDays(n.ipart, 1)
+				b.addFracSeconds(n.frac, timeh.SecsInDay)
+				if str != "" && str[0] != 'T' {
+					return fail(str, "unexpected character "+strconv.Quote(str[:1]))
+				}
+				datePart = false
+			default:
+				return fail(s[len(s)-len(str)-1:], "unknown date designator "+strconv.Quote(string(unit)))
+			}
+			if b.lostFraction() {
+				return fail(fieldStart, inexact)
+			}
+			if !datePart { // Date part ended by alternative format
+				haveField = false
+				continue
+			}
+		} else {
+			switch unit {
+			case 'H':
+				b.addSeconds(n.ipart, timeh.SecsInHour)
+				b.addFracSeconds(n.frac, timeh.SecsInHour)
+			case 'M':
+				b.addSeconds(n.ipart, timeh.SecsInMin)
+				b.addFracSeconds(n.frac, timeh.SecsInMin)
+			case 'S':
+				b.addSeconds(n.ipart, 1)
+				b.addFracSeconds(n.frac, 1)
+			case 0, ':':
+				// Alternative format, basic: hhmmss
+				if unit == 0 && iso8601IntegerWidth(fieldStart) == 6 && !haveField {
+					b.addSeconds(n.ipart/10000, timeh.SecsInHour)
+					b.addSeconds(n.ipart/100%100, timeh.SecsInMin)
+					b.addSeconds(n.ipart%100, 1)
+					b.addFracSeconds(n.frac, 1)
+					return result(fieldStart)
+				}
+
+				// Alternative format, extended: hh:mm:ss
+				if haveField {
+					return fail(fieldStart, "unexpected alternative format")
+				}
+				b.addSeconds(n.ipart, timeh.SecsInHour)
+				b.addFracSeconds(n.frac, timeh.SecsInHour)
+				if unit == 0 {
+					return result(fieldStart)
+				}
+
+				// minutes
+				if n, str, ok = parseIntervalNumber(str); !ok {
+					return fail(str, "invalid minutes")
+				}
+				b.addSeconds(n.ipart, timeh.SecsInMin)
+				b.addFracSeconds(n.frac, timeh.SecsInMin)
+				if str == "" {
+					return result(fieldStart)
+				}
+				if str[0] != ':' {
+					return fail(str, "unexpected character "+strconv.Quote(str[:1]))
+				}
+
+				// seconds
+				if n, str, ok = parseIntervalNumber(str[1:]); !ok {
+					return fail(str, "invalid seconds")
+				}
+				b.addSeconds(n.ipart, 1)
+				b.addFracSeconds(n.frac, 1)
+				if str != "" {
+					return fail(str, "unexpected character "+strconv.Quote(str[:1]))
+				}
+				return result(fieldStart)
+			default:
+				return fail(s[len(s)-len(str)-1:], "unknown time designator "+strconv.Quote(string(unit)))
+			}
+		}
+		if b.overflow {
+			return fail(fieldStart, "out of range field")
+		}
+		if b.lostFraction() {
+			return fail(fieldStart, inexact)
+		}
+		haveField = true
+	}
+
+	return b.result()
+}
+
+// ISO8601 returns string representation of interval in ISO 8601 format with designators.
+// Output is the same as PostgreSQL produces with IntervalStyle set to iso_8601.
+// Examples:
+//
+//	P1Y2M3DT4H5M6.789S
+//	P-1Y-2M3DT-4H-5M-6.789S
+//	PT0S
+func (i Interval) ISO8601() string {
+	if i.Months == 0 && i.Days == 0 && i.SomeSeconds == 0 {
+		return "PT0S"
+	}
+
+	str := "P"
+	addPart := func(v int64, unit string) {
+		if v != 0 {
+			str += strconvh.FormatInt64(v) + unit
+		}
+	}
+	addPart(int64(i.Months/timeh.MonthsInYear), "Y")
+	addPart(int64(i.Months%timeh.MonthsInYear), "M")
+	addPart(int64(i.Days), "D")
+
+	if i.SomeSeconds != 0 {
+		str += "T"
+		negative, h, m, s, f := intervalSecondsParts(i.SomeSeconds, i.precision)
+		sign := ""
+		if negative {
+			sign = "-"
+		}
+		if h != 0 {
+			str += sign + strconvh.FormatUint64(h) + "H"
+		}
+		if m != 0 {
+			str += sign + strconvh.FormatUint64(m) + "M"
+		}
+		if s != 0 || f != 0 {
+			str += sign + strconvh.FormatUint64(s) + formatIntervalFrac(f, i.precision) + "S"
+		}
+	}
+
+	return str
+}
+
+// ISO8601Alternative returns string representation of interval in ISO 8601 alternative format "PYYYY-MM-DDThh:mm:ss[.fff]".
+// Alternative format does not allow negative values, so ok is false if any part of interval is negative.
+// Values are not normalized: number of days and hours may be greater than 30 and 24 respectively.
+// Examples:
+//
+//	P0001-02-03T04:05:06.789
+//	P0000-00-45T100:00:00
+func (i Interval) ISO8601Alternative() (s string, ok bool) {
+	if i.Months < 0 || i.Days < 0 || i.SomeSeconds < 0 {
+		return "", false
+	}
+	_, h, m, sec, f := intervalSecondsParts(i.SomeSeconds, i.precision)
+	return "P" +
+		stringsh.PadLeftWithByte(strconvh.FormatInt32(i.Months/timeh.MonthsInYear), '0', 4) + "-" +
+		stringsh.PadLeftWithByte(strconvh.FormatInt32(i.Months%timeh.MonthsInYear), '0', 2) + "-" +
+		stringsh.PadLeftWithByte(strconvh.FormatInt32(i.Days), '0', 2) + "T" +
+		stringsh.PadLeftWithByte(strconvh.FormatUint64(h), '0', 2) + ":" +
+		stringsh.PadLeftWithByte(strconvh.FormatUint64(m), '0', 2) + ":" +
+		stringsh.PadLeftWithByte(strconvh.FormatUint64(sec), '0', 2) +
+		formatIntervalFrac(f, i.precision), true
+}
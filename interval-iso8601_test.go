@@ -0,0 +1,166 @@
+package pgtypes
+
+import (
+	"github.com/apaxa-go/helper/mathh"
+	"testing"
+	"testing/quick"
+)
+
+func TestParseIntervalISO8601(t *testing.T) {
+	type testElement struct {
+		s    string
+		prec uint8
+		i    Interval
+		err  bool
+	}
+
+	test := []testElement{
+		{"P1Y2M3DT4H5M6.789S", IntervalMicrosecondPrecision, Interval{14, 3, 14706789 * 1e3, IntervalMicrosecondPrecision}, false},
+		{"P-1Y-2M3DT-4H-5M-6S", IntervalMicrosecondPrecision, Interval{-14, 3, -14706 * 1e6, IntervalMicrosecondPrecision}, false},
+		{"P1Y-2M", IntervalMicrosecondPrecision, Interval{10, 0, 0, IntervalMicrosecondPrecision}, false},
+		{"PT0S", IntervalMicrosecondPrecision, Interval{0, 0, 0, IntervalMicrosecondPrecision}, false},
+		{"P2W", IntervalMicrosecondPrecision, Interval{0, 14, 0, IntervalMicrosecondPrecision}, false},
+		{"PT36H", IntervalMicrosecondPrecision, Interval{0, 0, 36 * 3600 * 1e6, IntervalMicrosecondPrecision}, false},
+		{"PT1H1H", IntervalMicrosecondPrecision, Interval{0, 0, 2 * 3600 * 1e6, IntervalMicrosecondPrecision}, false},
+		// Fractions (results are the same as in PostgreSQL)
+		{"P1.5Y", IntervalMicrosecondPrecision, Interval{18, 0, 0, IntervalMicrosecondPrecision}, false},
+		{"P0.5M", IntervalMicrosecondPrecision, Interval{0, 15, 0, IntervalMicrosecondPrecision}, false},
+		{"P0.1M", IntervalMicrosecondPrecision, Interval{0, 3, 0, IntervalMicrosecondPrecision}, false},
+		{"P0.05M", IntervalMicrosecondPrecision, Interval{0, 1, 12 * 3600 * 1e6, IntervalMicrosecondPrecision}, false},
+		{"P1.5W", IntervalMicrosecondPrecision, Interval{0, 10, 12 * 3600 * 1e6, IntervalMicrosecondPrecision}, false},
+		{"P1.5D", IntervalMicrosecondPrecision, Interval{0, 1, 12 * 3600 * 1e6, IntervalMicrosecondPrecision}, false},
+		{"P-1.5D", IntervalMicrosecondPrecision, Interval{0, -1, -12 * 3600 * 1e6, IntervalMicrosecondPrecision}, false},
+		{"PT1.5H", IntervalMicrosecondPrecision, Interval{0, 0, 5400 * 1e6, IntervalMicrosecondPrecision}, false},
+		{"PT0.5M", IntervalMicrosecondPrecision, Interval{0, 0, 30 * 1e6, IntervalMicrosecondPrecision}, false},
+		{"PT,5S", IntervalMicrosecondPrecision, Interval{0, 0, 5 * 1e5, IntervalMicrosecondPrecision}, false},
+		{"PT.5S", IntervalMicrosecondPrecision, Interval{0, 0, 5 * 1e5, IntervalMicrosecondPrecision}, false},
+		// Precision
+		{"PT0.0000005S", IntervalMicrosecondPrecision, Interval{0, 0, 1, IntervalMicrosecondPrecision}, false},
+		{"PT-0.0000005S", IntervalMicrosecondPrecision, Interval{0, 0, -1, IntervalMicrosecondPrecision}, false},
+		{"PT0.0000004S", IntervalMicrosecondPrecision, Interval{0, 0, 0, IntervalMicrosecondPrecision}, false},
+		{"PT0.123456789S", IntervalNanosecondPrecision, Interval{0, 0, 123456789, IntervalNanosecondPrecision}, false},
+		{"PT1S", 15, Interval{0, 0, 1e12, IntervalPicosecondPrecision}, false},
+		// Alternative format
+		{"P0001-02-03T04:05:06.789", IntervalMicrosecondPrecision, Interval{14, 3, 14706789 * 1e3, IntervalMicrosecondPrecision}, false},
+		{"P00010203T040506", IntervalMicrosecondPrecision, Interval{14, 3, 14706 * 1e6, IntervalMicrosecondPrecision}, false},
+		{"P00010203", IntervalMicrosecondPrecision, Interval{14, 3, 0, IntervalMicrosecondPrecision}, false},
+		{"P0001", IntervalMicrosecondPrecision, Interval{12, 0, 0, IntervalMicrosecondPrecision}, false},
+		{"P0001-02", IntervalMicrosecondPrecision, Interval{14, 0, 0, IntervalMicrosecondPrecision}, false},
+		{"P0001-02T10", IntervalMicrosecondPrecision, Interval{14, 0, 36000 * 1e6, IntervalMicrosecondPrecision}, false},
+		{"P0000-00-45T100:00:00", IntervalMicrosecondPrecision, Interval{0, 45, 360000 * 1e6, IntervalMicrosecondPrecision}, false},
+		{"PT10:30", IntervalMicrosecondPrecision, Interval{0, 0, 37800 * 1e6, IntervalMicrosecondPrecision}, false},
+		{"P1DT10:30", IntervalMicrosecondPrecision, Interval{0, 1, 37800 * 1e6, IntervalMicrosecondPrecision}, false},
+		{"P0000-00-00T-01:30:00", IntervalMicrosecondPrecision, Interval{0, 0, -1800 * 1e6, IntervalMicrosecondPrecision}, false},
+		// Errors
+		{"", IntervalMicrosecondPrecision, Interval{}, true},
+		{"P", IntervalMicrosecondPrecision, Interval{}, true},
+		{"1Y", IntervalMicrosecondPrecision, Interval{}, true},
+		{"p1Y", IntervalMicrosecondPrecision, Interval{}, true},
+		{"PY", IntervalMicrosecondPrecision, Interval{}, true},
+		{"P1X", IntervalMicrosecondPrecision, Interval{}, true},
+		{"PT1Y", IntervalMicrosecondPrecision, Interval{}, true},
+		{"PT1D", IntervalMicrosecondPrecision, Interval{}, true},
+		{"P1H", IntervalMicrosecondPrecision, Interval{}, true},
+		{"P1Y2", IntervalMicrosecondPrecision, Interval{}, true},
+		{"P1Y2-3", IntervalMicrosecondPrecision, Interval{}, true},
+		{"PT1H2", IntervalMicrosecondPrecision, Interval{}, true},
+		{"P0001-", IntervalMicrosecondPrecision, Interval{}, true},
+		{"P0001-02-", IntervalMicrosecondPrecision, Interval{}, true},
+		{"P0001-02-03-04", IntervalMicrosecondPrecision, Interval{}, true},
+		{"P0001-02:03", IntervalMicrosecondPrecision, Interval{}, true},
+		{"PT01:02:", IntervalMicrosecondPrecision, Interval{}, true},
+		{"PT01:02:03:04", IntervalMicrosecondPrecision, Interval{}, true},
+		{"PT01:02-03", IntervalMicrosecondPrecision, Interval{}, true},
+		{"P 1Y", IntervalMicrosecondPrecision, Interval{}, true},
+		{"P1Y ", IntervalMicrosecondPrecision, Interval{}, true},
+		// Overflow
+		{"P2147483648M", IntervalMicrosecondPrecision, Interval{}, true},
+		{"P178956971Y", IntervalMicrosecondPrecision, Interval{}, true},
+		{"P-2147483649D", IntervalMicrosecondPrecision, Interval{}, true},
+		{"P99999999999999999999D", IntervalMicrosecondPrecision, Interval{}, true},
+		{"PT2562047789H", IntervalMicrosecondPrecision, Interval{}, true},
+		{"PT9223372036854775807S", IntervalMicrosecondPrecision, Interval{}, true},
+		{"PT9223372036854775807S", IntervalSecondPrecision, Interval{0, 0, mathh.MaxInt64, IntervalSecondPrecision}, false},
+		{"PT9223372036854775807S1S", IntervalSecondPrecision, Interval{}, true},
+	}
+
+	for _, v := range test {
+		i, err := ParseIntervalISO8601(v.s, v.prec)
+		if (err != nil) != v.err {
+			t.Errorf("%v,%v: expect error %v, got %v", v.s, v.prec, v.err, err)
+		}
+		if !v.err && err == nil && i != v.i {
+			t.Errorf("%v,%v: expect %#v, got %#v", v.s, v.prec, v.i, i)
+		}
+	}
+}
+
+func TestInterval_ISO8601(t *testing.T) {
+	type testElement struct {
+		i Interval
+		s string
+	}
+
+	test := []testElement{
+		{Interval{14, 3, 14706789 * 1e6, IntervalNanosecondPrecision}, "P1Y2M3DT4H5M6.789S"},
+		{Interval{-14, -3, -14706789 * 1e6, IntervalNanosecondPrecision}, "P-1Y-2M-3DT-4H-5M-6.789S"},
+		{Interval{-10, 3, 14706 * 1e9, IntervalNanosecondPrecision}, "P-10M3DT4H5M6S"},
+		{Interval{0, 0, 0, IntervalNanosecondPrecision}, "PT0S"},
+		{Interval{12, 0, 0, IntervalNanosecondPrecision}, "P1Y"},
+		{Interval{0, 1000, 0, IntervalNanosecondPrecision}, "P1000D"},
+		{Interval{0, 0, 3600 * 1e9, IntervalNanosecondPrecision}, "PT1H"},
+		{Interval{0, 0, 1, IntervalNanosecondPrecision}, "PT0.000000001S"},
+		{Interval{0, 0, -1, IntervalPicosecondPrecision}, "PT-0.000000000001S"},
+		{Interval{0, 0, 125838, IntervalSecondPrecision}, "PT34H57M18S"},
+		{Interval{mathh.MaxInt32, mathh.MaxInt32, mathh.MaxInt64, IntervalSecondPrecision}, "P178956970Y7M2147483647DT2562047788015215H30M7S"},
+		{Interval{mathh.MinInt32, mathh.MinInt32, mathh.MinInt64, IntervalSecondPrecision}, "P-178956970Y-8M-2147483648DT-2562047788015215H-30M-8S"},
+		{Interval{0, 0, mathh.MinInt64, IntervalMicrosecondPrecision}, "PT-2562047788H-54.775808S"},
+	}
+
+	for _, v := range test {
+		if s := v.i.ISO8601(); s != v.s {
+			t.Errorf("%#v: expect %v, got %v", v.i, v.s, s)
+		}
+	}
+}
+
+func TestInterval_ISO8601Alternative(t *testing.T) {
+	type testElement struct {
+		i  Interval
+		s  string
+		ok bool
+	}
+
+	test := []testElement{
+		{Interval{14, 3, 14706789 * 1e6, IntervalNanosecondPrecision}, "P0001-02-03T04:05:06.789", true},
+		{Interval{0, 0, 0, IntervalNanosecondPrecision}, "P0000-00-00T00:00:00", true},
+		{Interval{0, 45, 360000 * 1e9, IntervalNanosecondPrecision}, "P0000-00-45T100:00:00", true},
+		{Interval{mathh.MaxInt32, mathh.MaxInt32, mathh.MaxInt64, IntervalSecondPrecision}, "P178956970-07-2147483647T2562047788015215:30:07", true},
+		{Interval{-1, 0, 0, IntervalNanosecondPrecision}, "", false},
+		{Interval{0, -1, 0, IntervalNanosecondPrecision}, "", false},
+		{Interval{0, 0, -1, IntervalNanosecondPrecision}, "", false},
+	}
+
+	for _, v := range test {
+		if s, ok := v.i.ISO8601Alternative(); s != v.s || ok != v.ok {
+			t.Errorf("%#v: expect %v %v, got %v %v", v.i, v.s, v.ok, s, ok)
+		}
+	}
+}
+
+func TestInterval_ISO8601Quick(t *testing.T) {
+	f := func(i Interval) bool {
+		r, err := ParseIntervalISO8601(i.ISO8601(), i.precision)
+		if err != nil || r != i {
+			return false
+		}
+		if s, ok := i.ISO8601Alternative(); ok {
+			r, err = ParseIntervalISO8601(s, i.precision)
+			return err == nil && r == i
+		}
+		return true
+	}
+	if err := quick.Check(f, quickConfig(10000)); err != nil {
+		t.Error(err)
+	}
+}
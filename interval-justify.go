@@ -0,0 +1,81 @@
+package pgtypes
+
+import (
+	"github.com/apaxa-go/helper/mathh"
+	"github.com/apaxa-go/helper/timeh"
+)
+
+// someSecondsInDay returns number of units of precision p in a day.
+func someSecondsInDay(p uint8) int64 {
+	return timeh.SecsInDay * mathh.PowInt64(10, int64(p))
+}
+
+// justifyDays moves whole months from days to months part.
+func justifyDays(months, days int64) (int64, int64) {
+	months += days / timeh.DaysInMonth
+	days %= timeh.DaysInMonth
+	return months, days
+}
+
+// JustifyDays returns interval with each 30 days period moved from days part to months part.
+// Signs of days and months parts are made the same (in the same way as PostgreSQL justify_days does).
+// Example: "1 mon 35 days" becomes "2 mons 5 days", "1 mon -5 days" becomes "25 days".
+// Seconds part is not changed.
+// It returns error if result months part overflows int32.
+func (i Interval) JustifyDays() (Interval, error) {
+	months, days := justifyDays(int64(i.Months), int64(i.Days))
+	if months > 0 && days < 0 {
+		days += timeh.DaysInMonth
+		months--
+	} else if months < 0 && days > 0 {
+		days -= timeh.DaysInMonth
+		months++
+	}
+	return newIntervalChecked(months, days, i.SomeSeconds, i.precision)
+}
+
+// JustifyHours returns interval with each 24 hours period moved from seconds part to days part.
+// Signs of seconds and days parts are made the same (in the same way as PostgreSQL justify_hours does).
+// Example: "27:00:00" becomes "1 day 03:00:00", "1 day -01:00:00" becomes "23:00:00".
+// Months part is not changed.
+// It returns error if result days part overflows int32.
+func (i Interval) JustifyHours() (Interval, error) {
+	day := someSecondsInDay(i.precision)
+	days := int64(i.Days) + i.SomeSeconds/day
+	ss := i.SomeSeconds % day
+	if days > 0 && ss < 0 {
+		ss += day
+		days--
+	} else if days < 0 && ss > 0 {
+		ss -= day
+		days++
+	}
+	return newIntervalChecked(int64(i.Months), days, ss, i.precision)
+}
+
+// JustifyInterval returns interval justified as by both JustifyHours and JustifyDays, with signs of all parts made the same (in the same way as PostgreSQL justify_interval does).
+// Example: "1 mon -01:00:00" becomes "29 days 23:00:00".
+// It returns error if result months part overflows int32.
+func (i Interval) JustifyInterval() (Interval, error) {
+	day := someSecondsInDay(i.precision)
+	months, days := int64(i.Months), int64(i.Days)+i.SomeSeconds/day
+	ss := i.SomeSeconds % day
+	months, days = justifyDays(months, days)
+
+	if months > 0 && (days < 0 || days == 0 && ss < 0) {
+		days += timeh.DaysInMonth
+		months--
+	} else if months < 0 && (days > 0 || days == 0 && ss > 0) {
+		days -= timeh.DaysInMonth
+		months++
+	}
+
+	if days > 0 && ss < 0 {
+		ss += day
+		days--
+	} else if days < 0 && ss > 0 {
+		ss -= day
+		days++
+	}
+	return newIntervalChecked(months, days, ss, i.precision)
+}
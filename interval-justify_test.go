@@ -0,0 +1,95 @@
+package pgtypes
+
+import (
+	"github.com/apaxa-go/helper/mathh"
+	"testing"
+)
+
+func TestInterval_JustifyDays(t *testing.T) {
+	type testElement struct {
+		i   Interval
+		r   Interval
+		err bool
+	}
+
+	const p = IntervalMicrosecondPrecision
+	test := []testElement{
+		{Interval{0, 35, 0, p}, Interval{1, 5, 0, p}, false},
+		{Interval{0, -35, 0, p}, Interval{-1, -5, 0, p}, false},
+		{Interval{1, 35, 100, p}, Interval{2, 5, 100, p}, false},
+		{Interval{1, -35, 0, p}, Interval{0, -5, 0, p}, false},
+		{Interval{2, -5, 0, p}, Interval{1, 25, 0, p}, false},
+		{Interval{-2, 5, 0, p}, Interval{-1, -25, 0, p}, false},
+		{Interval{0, 30, -1, p}, Interval{1, 0, -1, p}, false},
+		{Interval{0, 0, 0, p}, Interval{0, 0, 0, p}, false},
+		{Interval{mathh.MaxInt32, 29, 0, p}, Interval{mathh.MaxInt32, 29, 0, p}, false},
+		{Interval{mathh.MaxInt32, 30, 0, p}, Interval{}, true},
+		{Interval{mathh.MinInt32, -30, 0, p}, Interval{}, true},
+	}
+
+	for _, v := range test {
+		r, err := v.i.JustifyDays()
+		if (err != nil) != v.err || !v.err && r != v.r {
+			t.Errorf("%v: expect %#v %v, got %#v %v", v.i, v.r, v.err, r, err)
+		}
+	}
+}
+
+func TestInterval_JustifyHours(t *testing.T) {
+	type testElement struct {
+		i   Interval
+		r   Interval
+		err bool
+	}
+
+	const h = 3600 * 1e6
+	const p = IntervalMicrosecondPrecision
+	test := []testElement{
+		{Interval{0, 0, 27 * h, p}, Interval{0, 1, 3 * h, p}, false},
+		{Interval{0, 0, -27 * h, p}, Interval{0, -1, -3 * h, p}, false},
+		{Interval{0, 1, -1 * h, p}, Interval{0, 0, 23 * h, p}, false},
+		{Interval{0, -1, 1 * h, p}, Interval{0, 0, -23 * h, p}, false},
+		{Interval{5, -2, 49 * h, p}, Interval{5, 0, 1 * h, p}, false},
+		{Interval{0, 0, 24, IntervalSecondPrecision}, Interval{0, 0, 24, IntervalSecondPrecision}, false},
+		{Interval{0, 0, 86400 * 1e12, IntervalPicosecondPrecision}, Interval{0, 1, 0, IntervalPicosecondPrecision}, false},
+		{Interval{0, 0, mathh.MaxInt64, IntervalSecondPrecision}, Interval{}, true},
+		{Interval{0, mathh.MaxInt32, 24 * h, p}, Interval{}, true},
+	}
+
+	for _, v := range test {
+		r, err := v.i.JustifyHours()
+		if (err != nil) != v.err || !v.err && r != v.r {
+			t.Errorf("%v: expect %#v %v, got %#v %v", v.i, v.r, v.err, r, err)
+		}
+	}
+}
+
+func TestInterval_JustifyInterval(t *testing.T) {
+	type testElement struct {
+		i   Interval
+		r   Interval
+		err bool
+	}
+
+	const h = 3600 * 1e6
+	const p = IntervalMicrosecondPrecision
+	test := []testElement{
+		{Interval{1, 0, -1 * h, p}, Interval{0, 29, 23 * h, p}, false},
+		{Interval{-1, 0, 1 * h, p}, Interval{0, -29, -23 * h, p}, false},
+		{Interval{1, 1, -1 * h, p}, Interval{1, 0, 23 * h, p}, false},
+		{Interval{1, -1, 0, p}, Interval{0, 29, 0, p}, false},
+		{Interval{1, 0, -1e6, p}, Interval{0, 29, 86399 * 1e6, p}, false},
+		{Interval{0, 35, 27 * h, p}, Interval{1, 6, 3 * h, p}, false},
+		{Interval{0, 0, 0, p}, Interval{0, 0, 0, p}, false},
+		{Interval{0, mathh.MaxInt32, 24 * h, p}, Interval{71582788, 8, 0, p}, false},
+		{Interval{0, 0, mathh.MaxInt64, IntervalSecondPrecision}, Interval{}, true},
+		{Interval{mathh.MaxInt32, 30, 0, p}, Interval{}, true},
+	}
+
+	for _, v := range test {
+		r, err := v.i.JustifyInterval()
+		if (err != nil) != v.err || !v.err && r != v.r {
+			t.Errorf("%v: expect %#v %v, got %#v %v", v.i, v.r, v.err, r, err)
+		}
+	}
+}
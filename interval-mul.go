@@ -0,0 +1,139 @@
+package pgtypes
+
+import (
+	"github.com/apaxa-go/helper/mathh"
+	"github.com/apaxa-go/helper/timeh"
+	"math"
+	"math/big"
+)
+
+// rint rounds x to integer half to even (as C rint does).
+func rint(x float64) float64 {
+	t := math.Trunc(x)
+	if d := math.Abs(x - t); d > 0.5 || d == 0.5 && math.Mod(t, 2) != 0 {
+		t += math.Copysign(1, x)
+	}
+	return t
+}
+
+// tsRound rounds x to 6 digits after decimal point (as PostgreSQL TSROUND does).
+func tsRound(x float64) float64 {
+	const precInv = 1e6
+	return rint(x*precInv) / precInv
+}
+
+// float64FitsInt32 returns true if x (truncated to integer) fits int32. It returns false for NaN.
+func float64FitsInt32(x float64) bool {
+	return x >= math.MinInt32 && x < -math.MinInt32
+}
+
+// float64FitsInt64 returns true if x (truncated to integer) fits int64. It returns false for NaN.
+func float64FitsInt64(x float64) bool {
+	return x >= math.MinInt64 && x < -math.MinInt64
+}
+
+// scaleFloat64 applies op (multiplication or division by some factor) to i.
+// It is a port of PostgreSQL interval_mul and interval_div, so results are exactly the same.
+func (i Interval) scaleFloat64(op func(float64) float64) (Interval, error) {
+	monthsF, daysF := op(float64(i.Months)), op(float64(i.Days))
+	if !float64FitsInt32(monthsF) || !float64FitsInt32(daysF) {
+		return Interval{}, errIntervalOutOfRange
+	}
+	months, days := int64(monthsF), int64(daysF)
+
+	// Cascade fractional parts down: months to days and days to seconds
+	monthRemainderDays := tsRound((monthsF - float64(months)) * timeh.DaysInMonth)
+	secRemainder := tsRound((daysF - float64(days) + monthRemainderDays - math.Trunc(monthRemainderDays)) * timeh.SecsInDay)
+	if math.Abs(secRemainder) >= timeh.SecsInDay {
+		wholeDays := math.Trunc(secRemainder / timeh.SecsInDay)
+		days += int64(wholeDays)
+		secRemainder -= wholeDays * timeh.SecsInDay
+	}
+	days += int64(monthRemainderDays)
+
+	ss := rint(op(float64(i.SomeSeconds)) + secRemainder*float64(mathh.PowInt64(10, int64(i.precision))))
+	if !float64FitsInt64(ss) {
+		return Interval{}, errIntervalOutOfRange
+	}
+	return newIntervalChecked(months, days, int64(ss), i.precision)
+}
+
+// MulFloat64 returns interval i multiplied by f in the same way as PostgreSQL "interval * float8" does.
+// Fractional part of months cascades into days and fractional part of days cascades into seconds, so "1 mon" * 0.5 = "15 days".
+// Result is never justified: it may contain more than 30 days or more than 24 hours.
+// It returns error if f is NaN or if result overflows.
+func (i Interval) MulFloat64(f float64) (Interval, error) {
+	return i.scaleFloat64(func(v float64) float64 { return v * f })
+}
+
+// DivFloat64 returns interval i divided by f in the same way as PostgreSQL "interval / float8" does.
+// Fractional parts cascade in the same way as in MulFloat64, so "1 mon" / 4 = "7 days 12:00:00".
+// It returns error if f is zero or NaN or if result overflows.
+func (i Interval) DivFloat64(f float64) (Interval, error) {
+	if f == 0 {
+		return Interval{}, errIntervalDivideByZero
+	}
+	return i.scaleFloat64(func(v float64) float64 { return v / f })
+}
+
+// scaleRat returns interval i multiplied by f with the same cascading rules as in scaleFloat64, but calculations are exact.
+// Seconds part is rounded half to even.
+func (i Interval) scaleRat(f *big.Rat) (Interval, error) {
+	rat := func(v int64) *big.Rat { return new(big.Rat).SetInt64(v) }
+	mul := func(v int64) *big.Rat { return rat(v).Mul(rat(v), f) }
+
+	monthsR, daysR := mul(int64(i.Months)), mul(int64(i.Days))
+	months, ok1 := ratTruncInt64(monthsR)
+	days, ok2 := ratTruncInt64(daysR)
+	if _, ok := toInt32(months); !ok || !ok1 {
+		return Interval{}, errIntervalOutOfRange
+	}
+	if _, ok := toInt32(days); !ok || !ok2 {
+		return Interval{}, errIntervalOutOfRange
+	}
+
+	// Cascade fractional parts down: months to days and days to seconds
+	monthRemainderDays := monthsR.Sub(monthsR, rat(months))
+	monthRemainderDays.Mul(monthRemainderDays, rat(timeh.DaysInMonth))
+	wholeMonthRemainderDays, _ := ratTruncInt64(monthRemainderDays)
+	secRemainder := daysR.Sub(daysR, rat(days))
+	secRemainder.Add(secRemainder, monthRemainderDays.Sub(monthRemainderDays, rat(wholeMonthRemainderDays)))
+	wholeDays, _ := ratTruncInt64(secRemainder)
+	secRemainder.Sub(secRemainder, rat(wholeDays))
+	days += wholeDays + wholeMonthRemainderDays
+
+	ssR := mul(i.SomeSeconds)
+	ssR.Add(ssR, secRemainder.Mul(secRemainder, rat(timeh.SecsInDay*mathh.PowInt64(10, int64(i.precision)))))
+	ss, ok := ratRoundInt64(ssR, true)
+	if !ok {
+		return Interval{}, errIntervalOutOfRange
+	}
+	return newIntervalChecked(months, days, ss, i.precision)
+}
+
+// MulNumeric returns interval i multiplied by x.
+// Fractional parts cascade in the same way as in MulFloat64, but calculations are exact.
+// Seconds part of result is rounded half to even to the precision of i.
+// It returns error if x is NaN or if result overflows.
+func (i Interval) MulNumeric(x *Numeric) (Interval, error) {
+	f, ok := x.rat()
+	if !ok {
+		return Interval{}, errIntervalOutOfRange
+	}
+	return i.scaleRat(f)
+}
+
+// DivNumeric returns interval i divided by x.
+// Fractional parts cascade in the same way as in MulFloat64, but calculations are exact, so "1 mon" / 7 is exactly "4 days 06:51:25.714286" (with microsecond precision).
+// Seconds part of result is rounded half to even to the precision of i.
+// It returns error if x is zero or NaN or if result overflows.
+func (i Interval) DivNumeric(x *Numeric) (Interval, error) {
+	f, ok := x.rat()
+	if !ok {
+		return Interval{}, errIntervalOutOfRange
+	}
+	if f.Sign() == 0 {
+		return Interval{}, errIntervalDivideByZero
+	}
+	return i.scaleRat(f.Inv(f))
+}
@@ -0,0 +1,141 @@
+package pgtypes
+
+import (
+	"github.com/apaxa-go/helper/mathh"
+	"math"
+	"testing"
+)
+
+func TestInterval_MulFloat64(t *testing.T) {
+	type testElement struct {
+		i   Interval
+		f   float64
+		r   Interval
+		err bool
+	}
+
+	const p = IntervalMicrosecondPrecision
+	const sec = 1e6
+	// Results are the same as in PostgreSQL
+	test := []testElement{
+		{Interval{1, 0, 0, p}, 0.5, Interval{0, 15, 0, p}, false},
+		{Interval{-1, 0, 0, p}, 0.5, Interval{0, -15, 0, p}, false},
+		{Interval{1, 1, 0, p}, 0.5, Interval{0, 15, 43200 * sec, p}, false},
+		{Interval{0, 1, 0, p}, 1.5, Interval{0, 1, 43200 * sec, p}, false},
+		{Interval{0, 0, 3600 * sec, p}, 0.5, Interval{0, 0, 1800 * sec, p}, false},
+		{Interval{1, 1, 0, p}, 1.99, Interval{1, 31, 59616 * sec, p}, false},
+		{Interval{14, 3, 14706 * sec, p}, 2, Interval{28, 6, 29412 * sec, p}, false},
+		{Interval{1, 2, 3, p}, 0, Interval{0, 0, 0, p}, false},
+		{Interval{0, 0, 1, p}, 0.5, Interval{0, 0, 0, p}, false},
+		{Interval{0, 0, 3, p}, 0.5, Interval{0, 0, 2, p}, false},
+		{Interval{0, 0, 1, IntervalNanosecondPrecision}, 1.5, Interval{0, 0, 2, IntervalNanosecondPrecision}, false},
+		{Interval{1, 0, 0, p}, 1e10, Interval{}, true},
+		{Interval{0, 1, 0, p}, 2147483648, Interval{}, true},
+		{Interval{0, 0, mathh.MaxInt64, p}, 2, Interval{}, true},
+		{Interval{1, 0, 0, p}, math.NaN(), Interval{}, true},
+		{Interval{1, 0, 0, p}, math.Inf(1), Interval{}, true},
+	}
+
+	for _, v := range test {
+		r, err := v.i.MulFloat64(v.f)
+		if (err != nil) != v.err || !v.err && r != v.r {
+			t.Errorf("%v*%v: expect %#v %v, got %#v %v", v.i, v.f, v.r, v.err, r, err)
+		}
+	}
+}
+
+func TestInterval_DivFloat64(t *testing.T) {
+	type testElement struct {
+		i   Interval
+		f   float64
+		r   Interval
+		err bool
+	}
+
+	const p = IntervalMicrosecondPrecision
+	const sec = 1e6
+	// Results are the same as in PostgreSQL
+	test := []testElement{
+		{Interval{1, 0, 0, p}, 2, Interval{0, 15, 0, p}, false},
+		{Interval{1, 0, 0, p}, 3, Interval{0, 10, 0, p}, false},
+		{Interval{1, 0, 0, p}, 4, Interval{0, 7, 43200 * sec, p}, false},
+		{Interval{1, 0, 0, p}, 7, Interval{0, 4, 24685689600, p}, false},
+		{Interval{0, 1, 0, p}, -3, Interval{0, 0, -28800 * sec, p}, false},
+		{Interval{0, 0, 10 * sec, p}, 0.5, Interval{0, 0, 20 * sec, p}, false},
+		{Interval{1, 0, 0, p}, 0, Interval{}, true},
+		{Interval{1, 0, 0, p}, 1e-10, Interval{}, true},
+		{Interval{1, 0, 0, p}, math.NaN(), Interval{}, true},
+	}
+
+	for _, v := range test {
+		r, err := v.i.DivFloat64(v.f)
+		if (err != nil) != v.err || !v.err && r != v.r {
+			t.Errorf("%v/%v: expect %#v %v, got %#v %v", v.i, v.f, v.r, v.err, r, err)
+		}
+	}
+}
+
+func TestInterval_MulNumeric(t *testing.T) {
+	type testElement struct {
+		i   Interval
+		x   string
+		r   Interval
+		err bool
+	}
+
+	const p = IntervalMicrosecondPrecision
+	const sec = 1e6
+	test := []testElement{
+		{Interval{1, 0, 0, p}, "0.5", Interval{0, 15, 0, p}, false},
+		{Interval{1, 1, 0, p}, "0.5", Interval{0, 15, 43200 * sec, p}, false},
+		{Interval{1, 1, 0, p}, "1.99", Interval{1, 31, 59616 * sec, p}, false},
+		{Interval{1, 0, 0, p}, "-0.1", Interval{0, -3, 0, p}, false},
+		{Interval{0, 0, 1, p}, "0.5", Interval{0, 0, 0, p}, false},
+		{Interval{0, 0, 3, p}, "0.5", Interval{0, 0, 2, p}, false},
+		{Interval{0, 0, 1, p}, "0.0000000001", Interval{0, 0, 0, p}, false},
+		{Interval{0, 0, 1, p}, "9223372036854775807", Interval{0, 0, mathh.MaxInt64, p}, false},
+		{Interval{0, 0, 2, p}, "9223372036854775807", Interval{}, true},
+		{Interval{1, 0, 0, p}, "10000000000", Interval{}, true},
+		{Interval{0, 1, 0, p}, "2147483648", Interval{}, true},
+		{Interval{1, 0, 0, p}, "100000000000000000000", Interval{}, true},
+		{Interval{1, 0, 0, p}, "NaN", Interval{}, true},
+	}
+
+	for _, v := range test {
+		x, _ := NewNumeric().SetString(v.x)
+		r, err := v.i.MulNumeric(x)
+		if (err != nil) != v.err || !v.err && r != v.r {
+			t.Errorf("%v*%v: expect %#v %v, got %#v %v", v.i, v.x, v.r, v.err, r, err)
+		}
+	}
+}
+
+func TestInterval_DivNumeric(t *testing.T) {
+	type testElement struct {
+		i   Interval
+		x   string
+		r   Interval
+		err bool
+	}
+
+	const p = IntervalMicrosecondPrecision
+	const sec = 1e6
+	test := []testElement{
+		{Interval{1, 0, 0, p}, "3", Interval{0, 10, 0, p}, false},
+		{Interval{1, 0, 0, p}, "7", Interval{0, 4, 24685714286, p}, false},
+		{Interval{1, 0, 0, IntervalSecondPrecision}, "7", Interval{0, 4, 24686, IntervalSecondPrecision}, false},
+		{Interval{0, 1, 0, p}, "-3", Interval{0, 0, -28800 * sec, p}, false},
+		{Interval{0, 0, 10 * sec, p}, "0.5", Interval{0, 0, 20 * sec, p}, false},
+		{Interval{1, 0, 0, p}, "0", Interval{}, true},
+		{Interval{1, 0, 0, p}, "0.0000000001", Interval{}, true},
+		{Interval{1, 0, 0, p}, "NaN", Interval{}, true},
+	}
+
+	for _, v := range test {
+		x, _ := NewNumeric().SetString(v.x)
+		r, err := v.i.DivNumeric(x)
+		if (err != nil) != v.err || !v.err && r != v.r {
+			t.Errorf("%v/%v: expect %#v %v, got %#v %v", v.i, v.x, v.r, v.err, r, err)
+		}
+	}
+}
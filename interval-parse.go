@@ -0,0 +1,64 @@
+package pgtypes
+
+import (
+	"github.com/apaxa-go/helper/mathh"
+)
+
+// IntervalParseOptions configures ParseIntervalWithOptions.
+type IntervalParseOptions struct {
+	// Style is a style of input (see ParseIntervalWithStyle).
+	Style IntervalStyle
+	// Precision is a precision of result. If AutoPrecision is set then it is the minimal precision of result.
+	Precision uint8
+	// AutoPrecision makes precision of result the smallest one (but not less than Precision) which keeps all digits of fraction of second from input.
+	// Precision is limited by IntervalMaxPrecision and by the magnitude of seconds part (the greater it is, the smaller precision fits).
+	AutoPrecision bool
+	// Exact makes parsing fail instead of rounding if fraction of second does not fit precision.
+	Exact bool
+}
+
+// ParseIntervalWithOptions parses incoming string and extract interval as configured by opts.
+// Unlike ParseInterval (which rounds fraction of second half away from zero to precision p) it can detect required precision from the input and it can fail instead of rounding.
+// Examples (with AutoPrecision and Precision set to IntervalPgPrecision):
+//
+//	"1 day 02:03:04"               results in interval with microsecond precision;
+//	"00:00:01.123456789"           results in interval with nanosecond precision;
+//	"2562047 hours 0.0000000015 s" results in interval with nanosecond precision (the greatest one which fits) and fraction is rounded.
+//
+// If string can not be parsed then returned error is *ParseError.
+func ParseIntervalWithOptions(s string, opts IntervalParseOptions) (Interval, error) {
+	if !opts.AutoPrecision {
+		return parseIntervalWithStyle(s, opts.Precision, opts.Style, &intervalParseMode{exact: opts.Exact})
+	}
+
+	minP := opts.Precision
+	if minP > IntervalMaxPrecision {
+		minP = IntervalMaxPrecision
+	}
+	// Check syntax and range with the minimal precision and count digits of fraction of second
+	var mode intervalParseMode
+	i, err := parseIntervalWithStyle(s, minP, opts.Style, &mode)
+	if err != nil {
+		return i, err
+	}
+	// Precision required by fraction of second, decreased while seconds part does not fit it
+	p := mathh.Max2Uint8(minP, mode.digits)
+	if p > IntervalMaxPrecision {
+		p = IntervalMaxPrecision
+	}
+	for ; p > minP; p-- {
+		if limit := mathh.MaxInt64/mathh.PowInt64(10, int64(p-minP)) - 1; i.SomeSeconds <= limit && i.SomeSeconds >= -limit {
+			break
+		}
+	}
+	// Parse again only if precision differs or if fraction has to be checked for exactness
+	if p > minP || opts.Exact && mode.digits > minP {
+		if i, err = parseIntervalWithStyle(s, p, opts.Style, &intervalParseMode{exact: opts.Exact}); err != nil {
+			return i, err
+		}
+	}
+	if p := i.SafePrec(); p > minP {
+		return i.SetPrecision(p), nil
+	}
+	return i.SetPrecision(minP), nil
+}
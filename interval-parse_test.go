@@ -0,0 +1,97 @@
+package pgtypes
+
+import (
+	"testing"
+)
+
+func TestParseIntervalWithOptions(t *testing.T) {
+	type testElement struct {
+		s    string
+		opts IntervalParseOptions
+		i    Interval
+	}
+
+	auto := IntervalParseOptions{Precision: IntervalPgPrecision, AutoPrecision: true}
+	autoExact := IntervalParseOptions{Precision: IntervalPgPrecision, AutoPrecision: true, Exact: true}
+	test := []testElement{
+		{"", auto, Interval{0, 0, 0, 6}},
+		{"1 day 02:03:04", auto, Interval{0, 1, 7384000000, 6}},
+		{"00:00:01.1", auto, Interval{0, 0, 1100000, 6}},
+		{"00:00:01.123456789", auto, Interval{0, 0, 1123456789, 9}},
+		{"-00:00:01.123456789", autoExact, Interval{0, 0, -1123456789, 9}},
+		{"0.000000000001 seconds", auto, Interval{0, 0, 1, 12}},
+		{"0.0000000000015 seconds", auto, Interval{0, 0, 2, 12}},
+		{"PT0.0000001S", auto, Interval{0, 0, 1, 7}},
+		{"1 year 0.0000001 seconds", auto, Interval{12, 0, 1, 7}},
+		{"2562047 hours 0.0000000015 s", auto, Interval{0, 0, 9223369200000000002, 9}},
+		{"2562047 hours 0.000000001 s", autoExact, Interval{0, 0, 9223369200000000001, 9}},
+		{"2562047788 hours 0.5 seconds", auto, Interval{0, 0, 9223372036800500000, 6}},
+		{"00:00:01.1234567", IntervalParseOptions{Precision: 3}, Interval{0, 0, 1123, 3}},
+		{"00:00:01.123", IntervalParseOptions{Precision: 3, Exact: true}, Interval{0, 0, 1123, 3}},
+		{"00:00:01.123456789", IntervalParseOptions{Precision: 3, AutoPrecision: true}, Interval{0, 0, 1123456789, 9}},
+		{"00:00:01", IntervalParseOptions{Precision: 3, AutoPrecision: true}, Interval{0, 0, 1000, 3}},
+		{"1 01:00:00.5", IntervalParseOptions{Style: IntervalStyleSQLStandard, AutoPrecision: true}, Interval{0, 1, 36005, 1}},
+		{"P1DT1.25S", IntervalParseOptions{Style: IntervalStyleISO8601, AutoPrecision: true}, Interval{0, 1, 125, 2}},
+	}
+
+	for _, v := range test {
+		if i, err := ParseIntervalWithOptions(v.s, v.opts); err != nil || i != v.i {
+			t.Errorf("%v: expect %v, got %v (error: %v)", v.s, v.i, i, err)
+		}
+	}
+}
+
+func TestParseIntervalWithOptions2(t *testing.T) {
+	type testElement struct {
+		s      string
+		opts   IntervalParseOptions
+		offset int
+		reason string
+	}
+
+	exact := IntervalParseOptions{Precision: IntervalPgPrecision, Exact: true}
+	autoExact := IntervalParseOptions{Precision: IntervalPgPrecision, AutoPrecision: true, Exact: true}
+	test := []testElement{
+		{"00:00:01.1234567", exact, 0, `fraction beyond precision in field "00:00:01.1234567"`},
+		{"1 day 0.0000001 seconds", exact, 6, `fraction beyond precision in field "0.0000001"`},
+		{"1 day 0.0000000000001 seconds", autoExact, 6, `fraction beyond precision in field "0.0000000000001"`},
+		{"2562047 hours 0.0000000001 s", autoExact, 14, `fraction beyond precision in field "0.0000000001"`},
+		{"PT0.0000001S", exact, 2, "fraction beyond precision"},
+		{"1 dya", autoExact, 2, `unknown unit "dya"`},
+		{"2147483648 days", IntervalParseOptions{AutoPrecision: true}, 0, `out of range field "2147483648"`},
+	}
+
+	for _, v := range test {
+		_, err := ParseIntervalWithOptions(v.s, v.opts)
+		if e, ok := err.(*ParseError); !ok || e.Type != "interval" || e.Str != v.s || e.Offset != v.offset || e.Reason != v.reason {
+			t.Errorf("%v: expect error at %v with reason %v, got %#v", v.s, v.offset, v.reason, err)
+		}
+	}
+}
+
+// All digits of fraction are taken into account while rounding and large values do not overflow silently.
+func TestParseInterval3(t *testing.T) {
+	type testElement struct {
+		s  string
+		p  uint8
+		i  Interval
+		ok bool
+	}
+
+	test := []testElement{
+		{"00:00:00.1234565", 6, Interval{0, 0, 123457, 6}, true},
+		{"00:00:00.12345649999", 6, Interval{0, 0, 123456, 6}, true},
+		{"-00:00:00.0000004999999", 6, Interval{0, 0, 0, 6}, true},
+		{"-00:00:00.0000005", 6, Interval{0, 0, -1, 6}, true},
+		{"2562047 hours", 9, Interval{0, 0, 9223369200000000000, 9}, true},
+		{"2562047 hours", 12, Interval{}, false},
+		{"2562047788 hours", 12, Interval{}, false},
+	}
+
+	for _, v := range test {
+		i, err := ParseInterval(v.s, v.p)
+		if (err == nil) != v.ok || (v.ok && i != v.i) {
+			t.Errorf("%v: expect %v %v, got %v %v", v.s, v.i, v.ok, i, err)
+		}
+	}
+}
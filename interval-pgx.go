@@ -18,6 +18,8 @@ func init() {
 }
 
 // ScanPgx implements the pgx.PgxScanner interface.
+// Text representation of interval is parsed with auto detection of style, so it works with any PostgreSQL IntervalStyle setting.
+// Precision of result is detected from text representation in the same way as in Scan.
 func (i *Interval) ScanPgx(vr *pgx.ValueReader) error {
 	if vr.Type().DataType != IntervalOid {
 		return pgx.SerializationError(fmt.Sprintf("Interval.ScanPgx cannot decode %s (OID %d)", vr.Type().DataTypeName, vr.Type().DataType))
@@ -30,7 +32,7 @@ func (i *Interval) ScanPgx(vr *pgx.ValueReader) error {
 	switch vr.Type().FormatCode {
 	case pgx.TextFormatCode:
 		var err error
-		if *i, err = ParseInterval(vr.ReadString(vr.Len()), IntervalPgPrecision); err != nil {
+		if *i, err = ParseIntervalWithOptions(vr.ReadString(vr.Len()), intervalScanOptions); err != nil {
 			return pgx.SerializationError(fmt.Sprintf("received invalid Interval string: %v", err.Error())) // It is hard cover this case with test
 		}
 	case pgx.BinaryFormatCode:
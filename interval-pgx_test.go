@@ -4,6 +4,7 @@ import (
 	"github.com/jackc/pgx"
 	"strings"
 	"testing"
+	"time"
 )
 
 func testInterval_ScanPgx(t *testing.T) {
@@ -33,28 +34,41 @@ func testInterval_ScanPgx(t *testing.T) {
 		{"SELECT '-3 year 2 day -1 seconds'::INTERVAL", Interval{-3 * 12, 2, -1e6, IntervalPgPrecision}, false},
 		{"SELECT '-3 year 2 day -1.23456 seconds'::INTERVAL", Interval{-3 * 12, 2, -1234560, IntervalPgPrecision}, false},
 		{"SELECT '-3 year 2 day -1.234567 seconds'::INTERVAL", Interval{-3 * 12, 2, -1234567, IntervalPgPrecision}, false},
+		{"SELECT '-1 days +02:03:04'::INTERVAL", Interval{0, -1, 7384e6, IntervalPgPrecision}, false},
+		{"SELECT '-1 days -02:03:04'::INTERVAL", Interval{0, -1, -7384e6, IntervalPgPrecision}, false},
+		{"SELECT '-1 year -2 mons'::INTERVAL", Interval{-14, 0, 0, IntervalPgPrecision}, false},
+		{"SELECT '-0.5 seconds'::INTERVAL", Interval{0, 0, -5e5, IntervalPgPrecision}, false},
 		{"SELECT 'string'::TEXT", Interval{}, true},
 		{"SELECT null::interval", Interval{}, true},
 	}
 
-	for _, v := range tests {
-		if rows, err := pgxConn.Query(v.sql); err != nil { // Do not use QueryRow because it is harder to split error origin.
-			t.Errorf("%v: bad query", v.sql)
-		} else {
-			func() {
-				var r Interval
-				defer rows.Close()
-				if !rows.Next() {
-					t.Errorf("%v: no row", v.sql)
-				}
-				if err := rows.Scan(&r); (err != nil) != v.err || r != v.i {
-					t.Errorf("%v: expect %v %v, got %v %v", v.sql, v.i, v.err, r, err)
-				}
-				if rows.Next() {
-					t.Errorf("%v: multiple row", v.sql)
-				}
-			}()
+	// Text format should work with any IntervalStyle
+	for _, style := range intervalStyles {
+		if _, err := pgxConn.Exec("SET IntervalStyle TO " + style.String()); err != nil {
+			t.Fatalf("%v: unable to set IntervalStyle: %v", style, err)
 		}
+		for _, v := range tests {
+			if rows, err := pgxConn.Query(v.sql); err != nil { // Do not use QueryRow because it is harder to split error origin.
+				t.Errorf("%v,%v: bad query", v.sql, style)
+			} else {
+				func() {
+					var r Interval
+					defer rows.Close()
+					if !rows.Next() {
+						t.Errorf("%v,%v: no row", v.sql, style)
+					}
+					if err := rows.Scan(&r); (err != nil) != v.err || r != v.i {
+						t.Errorf("%v,%v: expect %v %v, got %v %v", v.sql, style, v.i, v.err, r, err)
+					}
+					if rows.Next() {
+						t.Errorf("%v,%v: multiple row", v.sql, style)
+					}
+				}()
+			}
+		}
+	}
+	if _, err := pgxConn.Exec("RESET IntervalStyle"); err != nil {
+		t.Fatalf("unable to reset IntervalStyle: %v", err)
 	}
 }
 
@@ -142,3 +156,115 @@ func TestInterval_Encode2(t *testing.T) {
 		rows.Close()
 	}
 }
+
+func TestInterval_MulFloat64Pg(t *testing.T) {
+	intervals := []Interval{
+		Interval{1, 0, 0, IntervalPgPrecision},
+		Interval{1, 1, 0, IntervalPgPrecision},
+		Interval{-14, 3, 14706789, IntervalPgPrecision},
+		Interval{5, -40, -90061e6, IntervalPgPrecision},
+		Interval{0, 0, 1, IntervalPgPrecision},
+	}
+	factors := []float64{0.5, 1.99, -3, 7, 1.0 / 3, 0.123456789, 1000}
+
+	for _, i := range intervals {
+		for _, f := range factors {
+			var mul, div Interval
+			if err := pgxConn.QueryRow("SELECT $1::INTERVAL * $2::FLOAT8, $1::INTERVAL / $2::FLOAT8", i, f).Scan(&mul, &div); err != nil {
+				t.Errorf("%v,%v: %v", i, f, err)
+				continue
+			}
+			if r, err := i.MulFloat64(f); err != nil || r != mul {
+				t.Errorf("%v*%v: expect %v, got %v %v", i, f, mul, r, err)
+			}
+			if r, err := i.DivFloat64(f); err != nil || r != div {
+				t.Errorf("%v/%v: expect %v, got %v %v", i, f, div, r, err)
+			}
+		}
+	}
+}
+
+func TestAgePg(t *testing.T) {
+	times := []string{
+		"1957-06-13 00:00:00",
+		"2001-04-10 00:00:00",
+		"2020-02-29 12:00:00.5",
+		"2021-01-31 00:00:00",
+		"2021-02-28 23:00:00",
+		"2021-03-01 00:00:00",
+		"2021-03-14 01:30:00",
+		"2021-03-15 00:00:00.000001",
+		"2021-11-07 01:30:00",
+	}
+	const layout = "2006-01-02 15:04:05.999999"
+
+	for _, tz := range []string{"UTC", "America/New_York"} {
+		loc, err := time.LoadLocation(tz)
+		if err != nil {
+			t.Errorf("%v: %v", tz, err)
+			continue
+		}
+		if _, err = pgxConn.Exec("SET TIME ZONE '" + tz + "'"); err != nil {
+			t.Errorf("%v: %v", tz, err)
+			continue
+		}
+		for _, s1 := range times {
+			for _, s2 := range times {
+				var r, rtz Interval
+				if err := pgxConn.QueryRow("SELECT age($2::TEXT::TIMESTAMP, $1::TEXT::TIMESTAMP), age($2::TEXT::TIMESTAMPTZ, $1::TEXT::TIMESTAMPTZ)", s1, s2).Scan(&r, &rtz); err != nil {
+					t.Errorf("%v,%v: %v", s1, s2, err)
+					continue
+				}
+				from, _ := time.Parse(layout, s1)
+				to, _ := time.Parse(layout, s2)
+				if i := Age(from, to).SetPrecision(IntervalPgPrecision); i != r {
+					t.Errorf("%v,%v: expect %v, got %v", s1, s2, r, i)
+				}
+				// Ambiguous local time is resolved in the same way as PostgreSQL does
+				if i := AgeIn(pgDate(from, loc).UTC(), pgDate(to, loc).UTC(), loc).SetPrecision(IntervalPgPrecision); i != rtz {
+					t.Errorf("%v,%v in %v: expect %v, got %v", s1, s2, tz, rtz, i)
+				}
+			}
+		}
+	}
+	if _, err := pgxConn.Exec("RESET TIME ZONE"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestIntervalAggregatesPg(t *testing.T) {
+	sets := [][]string{
+		{"1 mon", "0"},
+		{"1 mon", "1 day", "1 second"},
+		{"1 year 2 mons -3 days 04:05:06.789", "-1 mon 25:00:00", "7 days -00:00:00.000001"},
+		{"30 days", "1 mon", "720:00:00", "-1 day"},
+		{"1 mon", "29 days 24:00:00", "30 days"},
+		{"00:00:00.000001", "00:00:00.000002", "-00:00:00.000004"},
+	}
+
+	for _, set := range sets {
+		var vals []string
+		s := make([]Interval, len(set))
+		for k, v := range set {
+			vals = append(vals, "('"+v+"')")
+			s[k], _ = ParseInterval(v, IntervalPgPrecision)
+		}
+		var sum, avg, min, max NullInterval
+		if err := pgxConn.QueryRow("SELECT sum(v::INTERVAL), avg(v::INTERVAL), min(v::INTERVAL), max(v::INTERVAL) FROM (VALUES "+strings.Join(vals, ",")+") t(v)").Scan(&sum, &avg, &min, &max); err != nil {
+			t.Errorf("%v: %v", set, err)
+			continue
+		}
+		if r, err := SumIntervals(s); err != nil || r != sum {
+			t.Errorf("%v: expect sum %v, got %v %v", set, sum, r, err)
+		}
+		if r, err := AvgIntervals(s); err != nil || r != avg {
+			t.Errorf("%v: expect avg %v, got %v %v", set, avg, r, err)
+		}
+		if r := MinInterval(s); r != min {
+			t.Errorf("%v: expect min %v, got %v", set, min, r)
+		}
+		if r := MaxInterval(s); r != max {
+			t.Errorf("%v: expect max %v, got %v", set, max, r)
+		}
+	}
+}
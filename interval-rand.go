@@ -0,0 +1,58 @@
+package pgtypes
+
+import (
+	"github.com/apaxa-go/helper/mathh"
+	"github.com/apaxa-go/helper/timeh"
+	"math/rand"
+	"reflect"
+)
+
+// randInt64 returns pseudo-random number from [min; max] or [-limit; limit] (whichever is narrower).
+// With increased frequency it returns edge values: 0, min and max.
+// limit greater than MaxInt64/4 is silently replaced with MaxInt64/4.
+func randInt64(rnd *rand.Rand, min, max, limit int64) int64 {
+	switch rnd.Intn(8) {
+	case 0:
+		return 0
+	case 1:
+		return min
+	case 2:
+		return max
+	}
+	if limit <= 0 {
+		return 0
+	}
+	if limit > mathh.MaxInt64/4 {
+		limit = mathh.MaxInt64 / 4
+	}
+	if min < -limit {
+		min = -limit
+	}
+	if max > limit {
+		max = limit
+	}
+	return min + rnd.Int63n(max-min+1)
+}
+
+// Generate implements the quick.Generator interface.
+// It returns pseudo-random Interval with random precision.
+// Magnitude of parts is limited by size (size years, size days and size days in seconds part),
+// but with increased frequency each part is set to zero or to its limit.
+func (Interval) Generate(rnd *rand.Rand, size int) reflect.Value {
+	p := uint8(rnd.Intn(IntervalMaxPrecision + 1))
+	s := int64(mathh.Min2Int(mathh.Max2Int(size, 1), mathh.MaxInt32/timeh.MonthsInYear))
+
+	ssLimit := s * timeh.SecsInDay
+	if pow := mathh.PowInt64(10, int64(p)); ssLimit > mathh.MaxInt64/pow {
+		ssLimit = mathh.MaxInt64
+	} else {
+		ssLimit *= pow
+	}
+
+	return reflect.ValueOf(Interval{
+		Months:      int32(randInt64(rnd, mathh.MinInt32, mathh.MaxInt32, s*timeh.MonthsInYear)),
+		Days:        int32(randInt64(rnd, mathh.MinInt32, mathh.MaxInt32, s)),
+		SomeSeconds: randInt64(rnd, mathh.MinInt64, mathh.MaxInt64, ssLimit),
+		precision:   p,
+	})
+}
@@ -0,0 +1,23 @@
+package pgtypes
+
+import (
+	"github.com/apaxa-go/helper/mathh"
+	"testing"
+	"testing/quick"
+)
+
+func TestInterval_Generate(t *testing.T) {
+	var zero, min, max bool
+	f := func(i Interval) bool {
+		zero = zero || (i.Months == 0 && i.Days == 0 && i.SomeSeconds == 0)
+		min = min || i.Months == mathh.MinInt32 || i.Days == mathh.MinInt32 || i.SomeSeconds == mathh.MinInt64
+		max = max || i.Months == mathh.MaxInt32 || i.Days == mathh.MaxInt32 || i.SomeSeconds == mathh.MaxInt64
+		return i.precision <= IntervalMaxPrecision
+	}
+	if err := quick.Check(f, quickConfig(10000)); err != nil {
+		t.Error(err)
+	}
+	if !zero || !min || !max {
+		t.Errorf("expect edge cases, got %v %v %v", zero, min, max)
+	}
+}
@@ -0,0 +1,95 @@
+package pgtypes
+
+import (
+	"github.com/apaxa-go/helper/mathh"
+	"github.com/apaxa-go/helper/timeh"
+	"math/big"
+)
+
+// IntervalConvention defines how months and days parts of interval are converted to seconds.
+type IntervalConvention uint8
+
+// Possible conventions.
+const (
+	// IntervalConventionEpoch uses 365.25 days per each whole year of months part, 30 days per each remaining month and 24 hours per day (as PostgreSQL EXTRACT(EPOCH FROM interval) does).
+	IntervalConventionEpoch IntervalConvention = iota
+	// IntervalConventionMonth30 uses 30 days per month and 24 hours per day (as PostgreSQL interval comparison and justify_* functions do).
+	IntervalConventionMonth30
+)
+
+// someSecondsIn returns total number of units of interval precision in i, using convention c.
+// Unknown convention is treated as IntervalConventionEpoch.
+func (i Interval) someSecondsIn(c IntervalConvention) *big.Int {
+	var v *big.Int
+	if c == IntervalConventionMonth30 {
+		v = big.NewInt(int64(i.Months)*timeh.DaysInMonth + int64(i.Days))
+		v.Mul(v, big.NewInt(someSecondsInDay(i.precision)))
+	} else {
+		// Multiply everything by 4 to use integer arithmetic with 365.25 days per year
+		const daysPerYear4, daysPerMonth4 = 1461, 4 * timeh.DaysInMonth
+		v = big.NewInt(daysPerYear4*int64(i.Months/timeh.MonthsInYear) + daysPerMonth4*int64(i.Months%timeh.MonthsInYear) + 4*int64(i.Days))
+		v.Mul(v, big.NewInt(timeh.SecsInDay/4*mathh.PowInt64(10, int64(i.precision))))
+	}
+	return v.Add(v, big.NewInt(i.SomeSeconds))
+}
+
+// Seconds returns exact total number of seconds in i, using IntervalConventionEpoch.
+// It is the same as PostgreSQL EXTRACT(EPOCH FROM interval).
+// Unlike Duration it never overflows.
+func (i Interval) Seconds() *Numeric {
+	return i.SecondsIn(IntervalConventionEpoch)
+}
+
+// SecondsIn returns exact total number of seconds in i, using convention c.
+func (i Interval) SecondsIn(c IntervalConvention) *Numeric {
+	return NewNumeric().setScaled(i.someSecondsIn(c), int(i.precision))
+}
+
+// SecondsFloat64 returns total number of seconds in i, using IntervalConventionEpoch.
+// Result is the nearest float64 to the exact value.
+func (i Interval) SecondsFloat64() float64 {
+	return i.SecondsFloat64In(IntervalConventionEpoch)
+}
+
+// SecondsFloat64In returns total number of seconds in i, using convention c.
+// Result is the nearest float64 to the exact value.
+func (i Interval) SecondsFloat64In(c IntervalConvention) float64 {
+	r := new(big.Rat).SetFrac(i.someSecondsIn(c), big.NewInt(mathh.PowInt64(10, int64(i.precision))))
+	f, _ := r.Float64()
+	return f
+}
+
+// fromSecondsRat returns interval with precision p and seconds part equal to r seconds (rounded half to even).
+func fromSecondsRat(r *big.Rat, p uint8) (Interval, error) {
+	i := NewInterval(p)
+	r.Mul(r, new(big.Rat).SetInt64(mathh.PowInt64(10, int64(i.precision))))
+	ss, ok := ratRoundInt64(r, true)
+	if !ok {
+		return Interval{}, errIntervalOutOfRange
+	}
+	i.SomeSeconds = ss
+	return i, nil
+}
+
+// FromSeconds returns interval with precision p equal to x seconds (inverse of Seconds).
+// All value is stored in seconds part, months and days parts are zero (as PostgreSQL "x * interval '1 second'" does).
+// Value is rounded half to even to precision p.
+// It returns error if x is NaN or if result overflows.
+func FromSeconds(x *Numeric, p uint8) (Interval, error) {
+	r, ok := x.rat()
+	if !ok {
+		return Interval{}, errIntervalOutOfRange
+	}
+	return fromSecondsRat(r, p)
+}
+
+// FromSecondsFloat64 returns interval with precision p equal to f seconds (inverse of SecondsFloat64).
+// It works in the same way as FromSeconds, binary value of f is used exactly before rounding to precision p.
+// It returns error if f is NaN or infinity or if result overflows.
+func FromSecondsFloat64(f float64, p uint8) (Interval, error) {
+	r := new(big.Rat).SetFloat64(f)
+	if r == nil {
+		return Interval{}, errIntervalOutOfRange
+	}
+	return fromSecondsRat(r, p)
+}
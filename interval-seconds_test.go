@@ -0,0 +1,133 @@
+package pgtypes
+
+import (
+	"github.com/apaxa-go/helper/mathh"
+	"math"
+	"testing"
+)
+
+func TestInterval_SecondsIn(t *testing.T) {
+	type testElement struct {
+		i       Interval
+		epoch   string
+		month30 string
+	}
+
+	test := []testElement{
+		{Interval{0, 0, 0, IntervalMicrosecondPrecision}, "0", "0"},
+		{Interval{1, 0, 0, IntervalMicrosecondPrecision}, "2592000", "2592000"},
+		{Interval{12, 0, 0, IntervalMicrosecondPrecision}, "31557600", "31104000"},
+		{Interval{-14, 3, -14706789 * 1e3, IntervalMicrosecondPrecision}, "-36497106.789", "-36043506.789"},
+		{Interval{0, 1, -1, IntervalNanosecondPrecision}, "86399.999999999", "86399.999999999"},
+		{Interval{0, 0, 1, IntervalMaxPrecision}, "0.000000000001", "0.000000000001"},
+		{Interval{mathh.MaxInt32, mathh.MaxInt32, mathh.MaxInt64, IntervalMicrosecondPrecision}, "5842218453753654.775807", "5761043572161654.775807"},
+		{Interval{mathh.MinInt32, mathh.MinInt32, mathh.MinInt64, IntervalSecondPrecision}, "-9229205031939171008", "-9229123857057579008"},
+	}
+
+	for _, v := range test {
+		if r := v.i.Seconds().String(); r != v.epoch {
+			t.Errorf("%v: expect %v, got %v", v.i, v.epoch, r)
+		}
+		if r := v.i.SecondsIn(IntervalConventionEpoch).String(); r != v.epoch {
+			t.Errorf("%v: expect %v, got %v", v.i, v.epoch, r)
+		}
+		if r := v.i.SecondsIn(IntervalConventionMonth30).String(); r != v.month30 {
+			t.Errorf("%v: expect %v, got %v", v.i, v.month30, r)
+		}
+	}
+}
+
+func TestInterval_SecondsFloat64In(t *testing.T) {
+	type testElement struct {
+		i       Interval
+		epoch   float64
+		month30 float64
+	}
+
+	test := []testElement{
+		{Interval{0, 0, 0, IntervalMicrosecondPrecision}, 0, 0},
+		{Interval{12, 0, 0, IntervalMicrosecondPrecision}, 31557600, 31104000},
+		{Interval{-14, 3, -14706789 * 1e3, IntervalMicrosecondPrecision}, -36497106.789, -36043506.789},
+		{Interval{0, 0, 1, IntervalMaxPrecision}, 1e-12, 1e-12},
+		{Interval{mathh.MaxInt32, mathh.MaxInt32, mathh.MaxInt64, IntervalMicrosecondPrecision}, 5842218453753654.775807, 5761043572161654.775807},
+	}
+
+	for _, v := range test {
+		if r := v.i.SecondsFloat64(); r != v.epoch {
+			t.Errorf("%v: expect %v, got %v", v.i, v.epoch, r)
+		}
+		if r := v.i.SecondsFloat64In(IntervalConventionMonth30); r != v.month30 {
+			t.Errorf("%v: expect %v, got %v", v.i, v.month30, r)
+		}
+	}
+}
+
+func TestFromSeconds(t *testing.T) {
+	type testElement struct {
+		x   string
+		p   uint8
+		i   Interval
+		err bool
+	}
+
+	test := []testElement{
+		{"0", IntervalMicrosecondPrecision, Interval{0, 0, 0, IntervalMicrosecondPrecision}, false},
+		{"2592000", IntervalMicrosecondPrecision, Interval{0, 0, 2592000 * 1e6, IntervalMicrosecondPrecision}, false},
+		{"-36497106.789", IntervalMicrosecondPrecision, Interval{0, 0, -36497106789 * 1e3, IntervalMicrosecondPrecision}, false},
+		{"1.5", IntervalSecondPrecision, Interval{0, 0, 2, IntervalSecondPrecision}, false},
+		{"2.5", IntervalSecondPrecision, Interval{0, 0, 2, IntervalSecondPrecision}, false},
+		{"-2.5", IntervalSecondPrecision, Interval{0, 0, -2, IntervalSecondPrecision}, false},
+		{"0.0000000000015", 100, Interval{0, 0, 2, IntervalMaxPrecision}, false},
+		{"9223372036854.775807", IntervalMicrosecondPrecision, Interval{0, 0, mathh.MaxInt64, IntervalMicrosecondPrecision}, false},
+		{"9223372036854.775808", IntervalMicrosecondPrecision, Interval{}, true},
+		{"-9223372036854.775808", IntervalMicrosecondPrecision, Interval{0, 0, mathh.MinInt64, IntervalMicrosecondPrecision}, false},
+		{"NaN", IntervalMicrosecondPrecision, Interval{}, true},
+	}
+
+	for _, v := range test {
+		x, _ := NewNumeric().SetString(v.x)
+		i, err := FromSeconds(x, v.p)
+		if (err != nil) != v.err || !v.err && i != v.i {
+			t.Errorf("%v,%v: expect %#v %v, got %#v %v", v.x, v.p, v.i, v.err, i, err)
+		}
+	}
+}
+
+func TestFromSecondsFloat64(t *testing.T) {
+	type testElement struct {
+		f   float64
+		p   uint8
+		i   Interval
+		err bool
+	}
+
+	test := []testElement{
+		{0, IntervalMicrosecondPrecision, Interval{0, 0, 0, IntervalMicrosecondPrecision}, false},
+		{0.1, IntervalMaxPrecision, Interval{0, 0, 1e11, IntervalMaxPrecision}, false},
+		{-36497106.789, IntervalMicrosecondPrecision, Interval{0, 0, -36497106789 * 1e3, IntervalMicrosecondPrecision}, false},
+		{0.5, IntervalSecondPrecision, Interval{0, 0, 0, IntervalSecondPrecision}, false},
+		{1.5, IntervalSecondPrecision, Interval{0, 0, 2, IntervalSecondPrecision}, false},
+		{1e13, IntervalMicrosecondPrecision, Interval{}, true},
+		{math.NaN(), IntervalMicrosecondPrecision, Interval{}, true},
+		{math.Inf(-1), IntervalMicrosecondPrecision, Interval{}, true},
+	}
+
+	for _, v := range test {
+		i, err := FromSecondsFloat64(v.f, v.p)
+		if (err != nil) != v.err || !v.err && i != v.i {
+			t.Errorf("%v,%v: expect %#v %v, got %#v %v", v.f, v.p, v.i, v.err, i, err)
+		}
+	}
+}
+
+func TestInterval_Seconds_FromSeconds(t *testing.T) {
+	for _, i := range []Interval{
+		{0, 0, 123456789, IntervalNanosecondPrecision},
+		{0, 0, -987654321, IntervalMicrosecondPrecision},
+		{0, 0, mathh.MinInt64, IntervalMaxPrecision},
+	} {
+		if r, err := FromSeconds(i.Seconds(), i.Precision()); err != nil || r != i {
+			t.Errorf("%v: expect %v, got %v %v", i, i, r, err)
+		}
+	}
+}
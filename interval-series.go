@@ -0,0 +1,99 @@
+package pgtypes
+
+import (
+	"errors"
+	"github.com/apaxa-go/helper/mathh"
+	"math/big"
+	"time"
+)
+
+var (
+	errIntervalZeroStep       = errors.New("step size cannot equal zero")
+	errIntervalStrideMonths   = errors.New("timestamps cannot be binned into intervals containing months or years")
+	errIntervalStrideNegative = errors.New("stride must be greater than zero")
+)
+
+// TimeSeries iterates over timestamps from start to stop (inclusive) stepping by interval.
+// It works in the same way as PostgreSQL generate_series(timestamptz, timestamptz, interval) does: each next timestamp is the previous one plus step (added by AddToIn),
+// so series from "2021-01-31" with step "1 mon" is "2021-01-31", "2021-02-28", "2021-03-28", ...
+// Example:
+//
+//	s, err := NewTimeSeries(start, stop, step, time.UTC)
+//	if err != nil {
+//		return err
+//	}
+//	for s.Next() {
+//		t := s.Time()
+//		...
+//	}
+type TimeSeries struct {
+	cur, stop time.Time
+	step      Interval
+	loc       *time.Location
+	backward  bool
+	started   bool
+}
+
+// NewTimeSeries returns iterator over timestamps from start to stop (inclusive) stepping by step.
+// Months and days parts of step are added in location loc, use time.UTC to get the same result as PostgreSQL generate_series(timestamp, timestamp, interval).
+// Step direction is determined by CmpTotal: if step is positive then series is increasing and it is empty if start is after stop, and vice versa.
+// As in PostgreSQL, series is infinite if adding step does not move timestamp toward stop (this is possible for step like "1 mon -29 days").
+// It returns error if step is zero (as by CmpTotal).
+func NewTimeSeries(start, stop time.Time, step Interval, loc *time.Location) (*TimeSeries, error) {
+	sign := step.CmpTotal(Interval{})
+	if sign == 0 {
+		return nil, errIntervalZeroStep
+	}
+	return &TimeSeries{cur: start, stop: stop, step: step, loc: loc, backward: sign < 0}, nil
+}
+
+// Next advances iterator to the next timestamp, which will then be available through the Time method.
+// It returns false when series is over.
+func (s *TimeSeries) Next() bool {
+	if s.started {
+		s.cur = s.step.AddToIn(s.cur, s.loc)
+	}
+	s.started = true
+	if s.backward {
+		return !s.cur.Before(s.stop)
+	}
+	return !s.cur.After(s.stop)
+}
+
+// Time returns current timestamp of series.
+// It must be called only after Next returns true.
+func (s *TimeSeries) Time() time.Time {
+	return s.cur
+}
+
+// bigSomeSeconds returns t as number of units of precision p (which must be at least nanosecond) since the Unix epoch.
+func bigSomeSeconds(t time.Time, p uint8) *big.Int {
+	r := new(big.Int).Mul(big.NewInt(t.Unix()), big.NewInt(mathh.PowInt64(10, int64(p))))
+	return r.Add(r, big.NewInt(someSecondsChangePrecision(int64(t.Nanosecond()), IntervalNanosecondPrecision, p)))
+}
+
+// DateBin returns the beginning of the bin containing t, where bins have size stride and are aligned to origin.
+// It works in the same way as PostgreSQL date_bin(stride, t, origin) does: days in stride are 24 hours, and t before origin is binned toward minus infinity.
+// Example: for stride "15 mins" and origin "2001-01-01 00:00:00" time "2020-02-11 15:44:17" is binned to "2020-02-11 15:30:00".
+// Result is in the location of t and it is truncated toward minus infinity to nanoseconds if stride has greater precision.
+// It returns error if stride contains months or if it is not positive.
+func DateBin(stride Interval, t, origin time.Time) (time.Time, error) {
+	if stride.Months != 0 {
+		return time.Time{}, errIntervalStrideMonths
+	}
+	p := mathh.Max2Uint8(stride.precision, IntervalNanosecondPrecision)
+	size := new(big.Int).Mul(big.NewInt(int64(stride.Days)), big.NewInt(someSecondsInDay(p)))
+	size.Add(size, new(big.Int).Mul(big.NewInt(stride.SomeSeconds), big.NewInt(mathh.PowInt64(10, int64(p-stride.precision)))))
+	if size.Sign() <= 0 {
+		return time.Time{}, errIntervalStrideNegative
+	}
+
+	o := bigSomeSeconds(origin, p)
+	delta := new(big.Int).Sub(bigSomeSeconds(t, p), o)
+	delta.Sub(delta, new(big.Int).Mod(delta, size)) // Mod is Euclidean, so delta is rounded down
+	o.Add(o, delta)
+
+	secs, rem := new(big.Int).DivMod(o, big.NewInt(mathh.PowInt64(10, int64(p))), new(big.Int))
+	ns := rem.Int64() / mathh.PowInt64(10, int64(p-IntervalNanosecondPrecision)) // rem is not negative, so it is truncated
+	return time.Unix(secs.Int64(), ns).In(t.Location()), nil
+}
@@ -0,0 +1,106 @@
+package pgtypes
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTimeSeries(t *testing.T) {
+	type testElement struct {
+		start time.Time
+		stop  time.Time
+		step  Interval
+		loc   *time.Location
+		r     []time.Time
+	}
+
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone database is not available:", err)
+	}
+	const p = IntervalMicrosecondPrecision
+	const min = 60 * 1e6
+	utc := func(y int, m time.Month, d, hh, mm int) time.Time { return time.Date(y, m, d, hh, mm, 0, 0, time.UTC) }
+	// Results are the same as in PostgreSQL
+	test := []testElement{
+		{utc(2021, 1, 31, 0, 0), utc(2021, 5, 31, 0, 0), Interval{1, 0, 0, p}, time.UTC, []time.Time{utc(2021, 1, 31, 0, 0), utc(2021, 2, 28, 0, 0), utc(2021, 3, 28, 0, 0), utc(2021, 4, 28, 0, 0), utc(2021, 5, 28, 0, 0)}},
+		{utc(2021, 1, 1, 0, 0), utc(2021, 1, 1, 1, 0), Interval{0, 0, 30 * min, p}, time.UTC, []time.Time{utc(2021, 1, 1, 0, 0), utc(2021, 1, 1, 0, 30), utc(2021, 1, 1, 1, 0)}},
+		{utc(2021, 1, 1, 0, 0), utc(2021, 1, 1, 0, 59), Interval{0, 0, 30 * min, p}, time.UTC, []time.Time{utc(2021, 1, 1, 0, 0), utc(2021, 1, 1, 0, 30)}},
+		{utc(2021, 1, 1, 1, 0), utc(2021, 1, 1, 0, 0), Interval{0, 0, -30 * min, p}, time.UTC, []time.Time{utc(2021, 1, 1, 1, 0), utc(2021, 1, 1, 0, 30), utc(2021, 1, 1, 0, 0)}},
+		{utc(2021, 1, 1, 0, 0), utc(2021, 1, 1, 1, 0), Interval{0, 0, -30 * min, p}, time.UTC, []time.Time{}},
+		{utc(2021, 1, 1, 1, 0), utc(2021, 1, 1, 0, 0), Interval{0, 0, 30 * min, p}, time.UTC, []time.Time{}},
+		{utc(2021, 1, 1, 0, 0), utc(2021, 1, 1, 0, 0), Interval{0, 1, 0, p}, time.UTC, []time.Time{utc(2021, 1, 1, 0, 0)}},
+		{utc(2021, 1, 1, 0, 0), utc(2021, 3, 1, 0, 0), Interval{1, -1, 0, p}, time.UTC, []time.Time{utc(2021, 1, 1, 0, 0), utc(2021, 1, 31, 0, 0), utc(2021, 2, 27, 0, 0)}},
+		// 2021-03-13 12:00 EST, 2021-03-14 12:00 EDT, 2021-03-15 12:00 EDT
+		{utc(2021, 3, 13, 17, 0), utc(2021, 3, 15, 16, 0), Interval{0, 1, 0, p}, ny, []time.Time{utc(2021, 3, 13, 17, 0), utc(2021, 3, 14, 16, 0), utc(2021, 3, 15, 16, 0)}},
+		{utc(2021, 3, 13, 17, 0), utc(2021, 3, 15, 16, 0), Interval{0, 1, 0, p}, time.UTC, []time.Time{utc(2021, 3, 13, 17, 0), utc(2021, 3, 14, 17, 0)}},
+	}
+
+	for _, v := range test {
+		s, err := NewTimeSeries(v.start, v.stop, v.step, v.loc)
+		if err != nil {
+			t.Errorf("%v,%v,%v: unexpected error %v", v.start, v.stop, v.step, err)
+			continue
+		}
+		r := []time.Time{}
+		for s.Next() {
+			r = append(r, s.Time())
+		}
+		if !reflect.DeepEqual(r, v.r) {
+			t.Errorf("%v,%v,%v: expect %v, got %v", v.start, v.stop, v.step, v.r, r)
+		}
+	}
+
+	for _, step := range []Interval{{}, {1, -30, 0, p}, {0, 1, -24 * 60 * min, p}} {
+		if _, err := NewTimeSeries(utc(2021, 1, 1, 0, 0), utc(2021, 2, 1, 0, 0), step, time.UTC); err == nil {
+			t.Errorf("%v: expect error", step)
+		}
+	}
+}
+
+func TestDateBin(t *testing.T) {
+	type testElement struct {
+		stride Interval
+		t      string
+		origin string
+		r      string
+		err    bool
+	}
+
+	const p = IntervalMicrosecondPrecision
+	const min = 60 * 1e6
+	// Results are the same as in PostgreSQL
+	test := []testElement{
+		{Interval{0, 0, 15 * min, p}, "2020-02-11T15:44:17Z", "2001-01-01T00:00:00Z", "2020-02-11T15:30:00Z", false},
+		{Interval{0, 0, 15 * min, p}, "2020-02-11T15:44:17Z", "2001-01-01T00:02:30Z", "2020-02-11T15:32:30Z", false},
+		{Interval{0, 0, 60 * min, p}, "2000-12-31T23:30:00Z", "2001-01-01T00:00:00Z", "2000-12-31T23:00:00Z", false},
+		{Interval{0, 0, 60 * min, p}, "2000-12-31T23:00:00Z", "2001-01-01T00:00:00Z", "2000-12-31T23:00:00Z", false},
+		{Interval{0, 1, 0, p}, "2021-03-14T12:00:00Z", "2021-01-01T00:00:00Z", "2021-03-14T00:00:00Z", false},
+		{Interval{0, 1, 60 * min, p}, "2021-01-03T00:00:00Z", "2021-01-01T00:00:00Z", "2021-01-02T01:00:00Z", false},
+		{Interval{0, 7, 0, p}, "2021-01-01T02:00:00+03:00", "2021-01-01T00:00:00Z", "2020-12-25T03:00:00+03:00", false},
+		{Interval{0, 0, 1500, IntervalPicosecondPrecision}, "2021-01-01T00:00:00.000000004Z", "2021-01-01T00:00:00Z", "2021-01-01T00:00:00.000000003Z", false},
+		{Interval{0, 0, 1500, IntervalPicosecondPrecision}, "2021-01-01T00:00:00.000000002Z", "2021-01-01T00:00:00Z", "2021-01-01T00:00:00.000000001Z", false},
+		{Interval{0, 0, 1500, IntervalPicosecondPrecision}, "2020-12-31T23:59:59.999999999Z", "2021-01-01T00:00:00Z", "2020-12-31T23:59:59.999999998Z", false},
+		{Interval{0, 0, 1, IntervalSecondPrecision}, "2021-01-01T00:00:00.999999999Z", "2021-01-01T00:00:00Z", "2021-01-01T00:00:00Z", false},
+		{Interval{0, 0, 1, IntervalSecondPrecision}, "1000-01-01T00:00:00.5Z", "3000-01-01T00:00:00Z", "1000-01-01T00:00:00Z", false},
+		{Interval{1, 0, 0, p}, "2021-01-01T00:00:00Z", "2021-01-01T00:00:00Z", "", true},
+		{Interval{0, 0, 0, p}, "2021-01-01T00:00:00Z", "2021-01-01T00:00:00Z", "", true},
+		{Interval{0, 1, -25 * 60 * min, p}, "2021-01-01T00:00:00Z", "2021-01-01T00:00:00Z", "", true},
+	}
+
+	for _, v := range test {
+		tm, err := time.Parse(time.RFC3339Nano, v.t)
+		if err != nil {
+			t.Errorf("%v: error %v", v.t, err)
+		}
+		origin, err := time.Parse(time.RFC3339Nano, v.origin)
+		if err != nil {
+			t.Errorf("%v: error %v", v.origin, err)
+		}
+		r, err := DateBin(v.stride, tm, origin)
+		if (err != nil) != v.err || !v.err && r.Format(time.RFC3339Nano) != v.r {
+			t.Errorf("%v,%v,%v: expect %v %v, got %v %v", v.stride, v.t, v.origin, v.r, v.err, r.Format(time.RFC3339Nano), err)
+		}
+	}
+}
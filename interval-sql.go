@@ -6,17 +6,23 @@ import (
 	"fmt"
 )
 
+// intervalScanOptions is used to parse text representation of interval received from database.
+var intervalScanOptions = IntervalParseOptions{Style: IntervalStyleAuto, Precision: IntervalPgPrecision, AutoPrecision: true}
+
 // Scan implements the sql.Scanner interface.
+// Text representation of interval is parsed with auto detection of style, so it works with any PostgreSQL IntervalStyle setting.
+// Precision of result is detected from input, it is at least microsecond (as in PostgreSQL),
+// but values with more digits (e.g. from CockroachDB) keep them.
 func (i *Interval) Scan(src interface{}) (err error) {
 	switch src := src.(type) {
 	case []byte:
-		*i, err = ParseInterval(string(src), IntervalPgPrecision)
+		*i, err = ParseIntervalWithOptions(string(src), intervalScanOptions)
 		if err != nil {
 			err = errors.New("interval: " + err.Error())
 		}
 		return
 	case string:
-		*i, err = ParseInterval(src, IntervalPgPrecision)
+		*i, err = ParseIntervalWithOptions(src, intervalScanOptions)
 		if err != nil {
 			err = errors.New("interval: " + err.Error())
 		}
@@ -27,6 +33,7 @@ func (i *Interval) Scan(src interface{}) (err error) {
 }
 
 // Value implements the driver.Valuer interface.
+// Value is formatted in postgres style which is interpreted by PostgreSQL in the same way with any IntervalStyle setting.
 func (i Interval) Value() (driver.Value, error) {
-	return i.String(), nil
+	return i.StringStyle(IntervalStylePostgres), nil
 }
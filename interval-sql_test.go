@@ -29,28 +29,43 @@ func TestInterval_Scan(t *testing.T) {
 		{"SELECT '-3 year 2 day -1 seconds'::INTERVAL", Interval{-3 * 12, 2, -1e6, IntervalPgPrecision}, false},
 		{"SELECT '-3 year 2 day -1.23456 seconds'::INTERVAL", Interval{-3 * 12, 2, -1234560, IntervalPgPrecision}, false},
 		{"SELECT '-3 year 2 day -1.234567 seconds'::INTERVAL", Interval{-3 * 12, 2, -1234567, IntervalPgPrecision}, false},
+		{"SELECT '-1 days +02:03:04'::INTERVAL", Interval{0, -1, 7384e6, IntervalPgPrecision}, false},
+		{"SELECT '-1 days -02:03:04'::INTERVAL", Interval{0, -1, -7384e6, IntervalPgPrecision}, false},
+		{"SELECT '-1 year -2 mons'::INTERVAL", Interval{-14, 0, 0, IntervalPgPrecision}, false},
+		{"SELECT '-0.5 seconds'::INTERVAL", Interval{0, 0, -5e5, IntervalPgPrecision}, false},
 		{"SELECT 'string'::TEXT", Interval{}, true},
 		{"SELECT null::interval", Interval{}, true},
 	}
 
-	for _, v := range tests {
-		if rows, err := pqConn.Query(v.sql); err != nil { // Do not use QueryRow because it is harder to split error origin.
-			t.Errorf("%v: bad query", v.sql)
-		} else {
-			func() {
-				var r Interval
-				defer func() { _ = rows.Close() }()
-				if !rows.Next() {
-					t.Errorf("%v: no row", v.sql)
-				}
-				if err := rows.Scan(&r); (err != nil) != v.err || (!v.err && r != v.i) {
-					t.Errorf("%v: expect %#v %v, got %#v %v", v.sql, v.i, v.err, r, err)
-				}
-				if rows.Next() {
-					t.Errorf("%v: multiple row", v.sql)
-				}
-			}()
+	// Scan should work with any IntervalStyle
+	for _, style := range intervalStyles {
+		tx, err := pqConn.Begin()
+		if err != nil {
+			t.Fatalf("unable to begin transaction: %v", err)
+		}
+		if _, err = tx.Exec("SET LOCAL IntervalStyle TO " + style.String()); err != nil {
+			t.Fatalf("%v: unable to set IntervalStyle: %v", style, err)
+		}
+		for _, v := range tests {
+			if rows, err := tx.Query(v.sql); err != nil { // Do not use QueryRow because it is harder to split error origin.
+				t.Errorf("%v,%v: bad query", v.sql, style)
+			} else {
+				func() {
+					var r Interval
+					defer func() { _ = rows.Close() }()
+					if !rows.Next() {
+						t.Errorf("%v,%v: no row", v.sql, style)
+					}
+					if err := rows.Scan(&r); (err != nil) != v.err || (!v.err && r != v.i) {
+						t.Errorf("%v,%v: expect %#v %v, got %#v %v", v.sql, style, v.i, v.err, r, err)
+					}
+					if rows.Next() {
+						t.Errorf("%v,%v: multiple row", v.sql, style)
+					}
+				}()
+			}
 		}
+		_ = tx.Rollback()
 	}
 }
 
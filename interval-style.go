@@ -0,0 +1,239 @@
+package pgtypes
+
+import (
+	"github.com/apaxa-go/helper/mathh"
+	"github.com/apaxa-go/helper/strconvh"
+	"github.com/apaxa-go/helper/stringsh"
+	"github.com/apaxa-go/helper/timeh"
+)
+
+// IntervalStyle is a format of interval text representation.
+// It is the same as PostgreSQL IntervalStyle setting.
+type IntervalStyle uint8
+
+// Possible interval styles.
+const (
+	IntervalStylePostgres        IntervalStyle = iota // "1 year 2 mons 3 days 04:05:06", default PostgreSQL style
+	IntervalStylePostgresVerbose                      // "@ 1 year 2 mons 3 days 4 hours 5 mins 6 secs"
+	IntervalStyleSQLStandard                          // "+1-2 +3 +4:05:06"
+	IntervalStyleISO8601                              // "P1Y2M3DT4H5M6S"
+	IntervalStyleAuto                                 // Detect style on parsing, IntervalStylePostgres on formatting
+)
+
+// String returns name of style as it used in PostgreSQL IntervalStyle setting.
+func (s IntervalStyle) String() string {
+	switch s {
+	case IntervalStylePostgres:
+		return "postgres"
+	case IntervalStylePostgresVerbose:
+		return "postgres_verbose"
+	case IntervalStyleSQLStandard:
+		return "sql_standard"
+	case IntervalStyleISO8601:
+		return "iso_8601"
+	case IntervalStyleAuto:
+		return "auto"
+	default:
+		return "IntervalStyle(" + strconvh.FormatUint8(uint8(s)) + ")"
+	}
+}
+
+// ParseIntervalWithStyle parses incoming string in the given style and extract interval with requested precision p.
+// Styles other than IntervalStyleISO8601 accept full PostgreSQL input syntax (see ParseInterval), they differ only in the meaning of leading minus (as in PostgreSQL).
+// If style is IntervalStyleAuto then string is parsed as ISO 8601 if it begins with "P", otherwise it is parsed as IntervalStyleSQLStandard.
+// Auto detection is safe for PostgreSQL output with any IntervalStyle setting.
+func ParseIntervalWithStyle(s string, p uint8, style IntervalStyle) (Interval, error) {
+	return parseIntervalWithStyle(s, p, style, nil)
+}
+
+// parseIntervalWithStyle parses interval in the given style.
+// Mode configures parsing of fraction of second, it may be nil.
+func parseIntervalWithStyle(s string, p uint8, style IntervalStyle, mode *intervalParseMode) (Interval, error) {
+	switch style {
+	case IntervalStylePostgres:
+		if s == "" {
+			return NewInterval(p), nil
+		}
+		return parseInterval(s, p, false, mode)
+	case IntervalStylePostgresVerbose:
+		return parseInterval(s, p, false, mode)
+	case IntervalStyleISO8601:
+		return parseIntervalISO8601(s, p, mode)
+	default:
+		// PostgreSQL output in postgres and postgres_verbose styles never begins with minus without explicit signs of the following fields, so it is safe to parse it as sql_standard.
+		return parseInterval(s, p, true, mode)
+	}
+}
+
+// StringStyle returns string representation of interval in the given style.
+// Output is the same as PostgreSQL produces with the same IntervalStyle setting (except that precision of seconds is not limited to microseconds).
+// IntervalStyleAuto is formatted as IntervalStylePostgres.
+func (i Interval) StringStyle(style IntervalStyle) string {
+	switch style {
+	case IntervalStylePostgresVerbose:
+		return i.postgresVerboseString()
+	case IntervalStyleSQLStandard:
+		return i.sqlStandardString()
+	case IntervalStyleISO8601:
+		return i.ISO8601()
+	default:
+		return i.postgresString()
+	}
+}
+
+// formatIntervalTime returns time as "h:mm:ss[.fff]".
+// If padHours is true then hours are padded to 2 digits.
+func formatIntervalTime(h, m, s, f uint64, p uint8, padHours bool) string {
+	hs := strconvh.FormatUint64(h)
+	if padHours {
+		hs = stringsh.PadLeftWithByte(hs, '0', 2)
+	}
+	return hs + ":" +
+		stringsh.PadLeftWithByte(strconvh.FormatUint64(m), '0', 2) + ":" +
+		stringsh.PadLeftWithByte(strconvh.FormatUint64(s), '0', 2) +
+		formatIntervalFrac(f, p)
+}
+
+// postgresString returns string representation of interval in postgres style.
+// It is a port of PostgreSQL EncodeInterval (INTSTYLE_POSTGRES).
+func (i Interval) postgresString() string {
+	str := ""
+	isZero, isBefore := true, false
+	addPart := func(v int32, unit string) {
+		if v == 0 {
+			return
+		}
+		if !isZero {
+			str += " "
+		}
+		if isBefore && v > 0 {
+			str += "+"
+		}
+		str += strconvh.FormatInt32(v) + " " + unit
+		if v != 1 {
+			str += "s"
+		}
+		isBefore = v < 0
+		isZero = false
+	}
+	addPart(i.Months/timeh.MonthsInYear, "year")
+	addPart(i.Months%timeh.MonthsInYear, "mon")
+	addPart(i.Days, "day")
+
+	if isZero || i.SomeSeconds != 0 {
+		negative, h, m, s, f := intervalSecondsParts(i.SomeSeconds, i.precision)
+		if !isZero {
+			str += " "
+		}
+		if negative {
+			str += "-"
+		} else if isBefore {
+			str += "+"
+		}
+		str += formatIntervalTime(h, m, s, f, i.precision, true)
+	}
+
+	return str
+}
+
+// postgresVerboseString returns string representation of interval in postgres_verbose style.
+// It is a port of PostgreSQL EncodeInterval (INTSTYLE_POSTGRES_VERBOSE).
+func (i Interval) postgresVerboseString() string {
+	str := "@"
+	isZero, isBefore := true, false
+	addPart := func(v int64, unit string) {
+		if v == 0 {
+			return
+		}
+		if isZero {
+			isBefore = v < 0
+			if isBefore {
+				v = -v
+			}
+		} else if isBefore {
+			v = -v
+		}
+		str += " " + strconvh.FormatInt64(v) + " " + unit
+		if v != 1 {
+			str += "s"
+		}
+		isZero = false
+	}
+	addPart(int64(i.Months/timeh.MonthsInYear), "year")
+	addPart(int64(i.Months%timeh.MonthsInYear), "mon")
+	addPart(int64(i.Days), "day")
+
+	negative, h, m, s, f := intervalSecondsParts(i.SomeSeconds, i.precision)
+	sign := int64(1)
+	if negative {
+		sign = -1
+	}
+	addPart(sign*int64(h), "hour")
+	addPart(sign*int64(m), "min")
+
+	if s != 0 || f != 0 {
+		str += " "
+		if negative {
+			if isZero {
+				isBefore = true
+			} else if !isBefore {
+				str += "-"
+			}
+		} else if isBefore {
+			str += "-"
+		}
+		str += strconvh.FormatUint64(s) + formatIntervalFrac(f, i.precision) + " sec"
+		if s != 1 || f != 0 {
+			str += "s"
+		}
+		isZero = false
+	}
+
+	if isZero {
+		str += " 0"
+	}
+	if isBefore {
+		str += " ago"
+	}
+	return str
+}
+
+// sqlStandardString returns string representation of interval in sql_standard style.
+// It is a port of PostgreSQL EncodeInterval (INTSTYLE_SQL_STANDARD).
+func (i Interval) sqlStandardString() string {
+	hasNegative := i.Months < 0 || i.Days < 0 || i.SomeSeconds < 0
+	hasPositive := i.Months > 0 || i.Days > 0 || i.SomeSeconds > 0
+	hasYearMonth := i.Months != 0
+	hasDayTime := i.Days != 0 || i.SomeSeconds != 0
+	sqlStandardValue := !(hasNegative && hasPositive) && !(hasYearMonth && hasDayTime)
+
+	// Work with absolute values, signs are added explicitly
+	abs := func(v int32) string { return strconvh.FormatInt64(mathh.AbsInt64(int64(v))) }
+	y, mon := abs(i.Months/timeh.MonthsInYear), abs(i.Months%timeh.MonthsInYear)
+	d := abs(i.Days)
+	negativeTime, h, m, s, f := intervalSecondsParts(i.SomeSeconds, i.precision)
+
+	str := ""
+	if hasNegative && sqlStandardValue {
+		str = "-"
+	}
+
+	switch {
+	case !hasNegative && !hasPositive:
+		return "0"
+	case !sqlStandardValue:
+		sign := func(negative bool) string {
+			if negative {
+				return "-"
+			}
+			return "+"
+		}
+		return sign(i.Months < 0) + y + "-" + mon + " " + sign(i.Days < 0) + d + " " + sign(negativeTime) + formatIntervalTime(h, m, s, f, i.precision, false)
+	case hasYearMonth:
+		return str + y + "-" + mon
+	case i.Days != 0:
+		return str + d + " " + formatIntervalTime(h, m, s, f, i.precision, false)
+	default:
+		return str + formatIntervalTime(h, m, s, f, i.precision, false)
+	}
+}
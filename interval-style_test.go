@@ -0,0 +1,170 @@
+package pgtypes
+
+import (
+	"testing"
+	"testing/quick"
+)
+
+var intervalStyles = []IntervalStyle{IntervalStylePostgres, IntervalStylePostgresVerbose, IntervalStyleSQLStandard, IntervalStyleISO8601}
+
+func TestIntervalStyle_String(t *testing.T) {
+	type testElement struct {
+		style IntervalStyle
+		s     string
+	}
+
+	test := []testElement{
+		{IntervalStylePostgres, "postgres"},
+		{IntervalStylePostgresVerbose, "postgres_verbose"},
+		{IntervalStyleSQLStandard, "sql_standard"},
+		{IntervalStyleISO8601, "iso_8601"},
+		{IntervalStyleAuto, "auto"},
+		{IntervalStyle(100), "IntervalStyle(100)"},
+	}
+
+	for _, v := range test {
+		if s := v.style.String(); s != v.s {
+			t.Errorf("%d: expect %v, got %v", v.style, v.s, s)
+		}
+	}
+}
+
+// Expected strings are taken from PostgreSQL regression tests and PostgreSQL output.
+func TestInterval_StringStyle(t *testing.T) {
+	type testElement struct {
+		i                                   Interval
+		postgres, verbose, sqlStandard, iso string
+	}
+
+	test := []testElement{
+		{Interval{0, 0, 0, IntervalPgPrecision}, "00:00:00", "@ 0", "0", "PT0S"},
+		{Interval{14, 0, 0, IntervalPgPrecision}, "1 year 2 mons", "@ 1 year 2 mons", "1-2", "P1Y2M"},
+		{Interval{-14, 0, 0, IntervalPgPrecision}, "-1 years -2 mons", "@ 1 year 2 mons ago", "-1-2", "P-1Y-2M"},
+		{Interval{0, 1, 7384e6, IntervalPgPrecision}, "1 day 02:03:04", "@ 1 day 2 hours 3 mins 4 secs", "1 2:03:04", "P1DT2H3M4S"},
+		{Interval{0, -1, -7384e6, IntervalPgPrecision}, "-1 days -02:03:04", "@ 1 day 2 hours 3 mins 4 secs ago", "-1 2:03:04", "P-1DT-2H-3M-4S"},
+		{Interval{0, 1, -3600e6, IntervalPgPrecision}, "1 day -01:00:00", "@ 1 day -1 hours", "+0-0 +1 -1:00:00", "P1DT-1H"},
+		{Interval{0, -1, 3600e6, IntervalPgPrecision}, "-1 days +01:00:00", "@ 1 day -1 hours ago", "+0-0 -1 +1:00:00", "P-1DT1H"},
+		{Interval{14, -3, 14706789e3, IntervalPgPrecision}, "1 year 2 mons -3 days +04:05:06.789", "@ 1 year 2 mons -3 days 4 hours 5 mins 6.789 secs", "+1-2 -3 +4:05:06.789", "P1Y2M-3DT4H5M6.789S"},
+		{Interval{-14, 3, -14706789e3, IntervalPgPrecision}, "-1 years -2 mons +3 days -04:05:06.789", "@ 1 year 2 mons -3 days 4 hours 5 mins 6.789 secs ago", "-1-2 +3 -4:05:06.789", "P-1Y-2M3DT-4H-5M-6.789S"},
+		{Interval{-10, -3, 14106700e3, IntervalPgPrecision}, "-10 mons -3 days +03:55:06.7", "@ 10 mons 3 days -3 hours -55 mins -6.7 secs ago", "-0-10 -3 +3:55:06.7", "P-10M-3DT3H55M6.7S"},
+		{Interval{14, 3, 14706699999, IntervalPgPrecision}, "1 year 2 mons 3 days 04:05:06.699999", "@ 1 year 2 mons 3 days 4 hours 5 mins 6.699999 secs", "+1-2 +3 +4:05:06.699999", "P1Y2M3DT4H5M6.699999S"},
+		{Interval{0, 0, 700e3, IntervalPgPrecision}, "00:00:00.7", "@ 0.7 secs", "0:00:00.7", "PT0.7S"},
+		{Interval{0, 0, 1e6, IntervalPgPrecision}, "00:00:01", "@ 1 sec", "0:00:01", "PT1S"},
+		{Interval{0, 0, -1e6, IntervalPgPrecision}, "-00:00:01", "@ 1 sec ago", "-0:00:01", "PT-1S"},
+		{Interval{0, 1, 0, IntervalPgPrecision}, "1 day", "@ 1 day", "1 0:00:00", "P1D"},
+		{Interval{0, 0, 125838e6, IntervalPgPrecision}, "34:57:18", "@ 34 hours 57 mins 18 secs", "34:57:18", "PT34H57M18S"},
+		{Interval{0, 0, 1, IntervalPicosecondPrecision}, "00:00:00.000000000001", "@ 0.000000000001 secs", "0:00:00.000000000001", "PT0.000000000001S"},
+	}
+
+	for _, v := range test {
+		for j, s := range []string{v.postgres, v.verbose, v.sqlStandard, v.iso} {
+			if r := v.i.StringStyle(intervalStyles[j]); r != s {
+				t.Errorf("%#v,%v: expect %v, got %v", v.i, intervalStyles[j], s, r)
+			}
+		}
+		if r := v.i.StringStyle(IntervalStyleAuto); r != v.postgres {
+			t.Errorf("%#v,%v: expect %v, got %v", v.i, IntervalStyleAuto, v.postgres, r)
+		}
+	}
+}
+
+func TestParseIntervalWithStyle(t *testing.T) {
+	type testElement struct {
+		s     string
+		style IntervalStyle
+		i     Interval
+		err   bool
+	}
+
+	test := []testElement{
+		{"1 year 2 mons -3 days +04:05:06.789", IntervalStylePostgres, Interval{14, -3, 14706789e3, IntervalPgPrecision}, false},
+		{"P1Y2M-3DT4H5M6.789S", IntervalStyleISO8601, Interval{14, -3, 14706789e3, IntervalPgPrecision}, false},
+		// postgres_verbose
+		{"@ 1 year 2 mons", IntervalStylePostgresVerbose, Interval{14, 0, 0, IntervalPgPrecision}, false},
+		{"@ 1 year 2 mons ago", IntervalStylePostgresVerbose, Interval{-14, 0, 0, IntervalPgPrecision}, false},
+		{"@ 10 mons 3 days -3 hours -55 mins -6.7 secs ago", IntervalStylePostgresVerbose, Interval{-10, -3, 14106700e3, IntervalPgPrecision}, false},
+		{"@ 0.7 secs", IntervalStylePostgresVerbose, Interval{0, 0, 700e3, IntervalPgPrecision}, false},
+		{"@ 0", IntervalStylePostgresVerbose, Interval{0, 0, 0, IntervalPgPrecision}, false},
+		{"@ 1 DAY", IntervalStylePostgresVerbose, Interval{0, 1, 0, IntervalPgPrecision}, false},
+		{"-1 2:03:04", IntervalStylePostgresVerbose, Interval{0, -1, 7384e6, IntervalPgPrecision}, false},
+		{"@ 1 day 1 day", IntervalStylePostgresVerbose, Interval{}, true},
+		{"@ 1 day days", IntervalStylePostgresVerbose, Interval{}, true},
+		{"@ 1 day ago 2 hours", IntervalStylePostgresVerbose, Interval{}, true},
+		{"@ 1 ago", IntervalStylePostgresVerbose, Interval{}, true},
+		{"@ ago", IntervalStylePostgresVerbose, Interval{}, true},
+		{"@ days", IntervalStylePostgresVerbose, Interval{}, true},
+		{"@", IntervalStylePostgresVerbose, Interval{}, true},
+		{"@ 1 fortnight", IntervalStylePostgresVerbose, Interval{}, true},
+		{"@ 1 hour 01:00:00", IntervalStylePostgresVerbose, Interval{}, true},
+		// sql_standard
+		{"0", IntervalStyleSQLStandard, Interval{0, 0, 0, IntervalPgPrecision}, false},
+		{"1-2", IntervalStyleSQLStandard, Interval{14, 0, 0, IntervalPgPrecision}, false},
+		{"-1-2", IntervalStyleSQLStandard, Interval{-14, 0, 0, IntervalPgPrecision}, false},
+		{"1 2:03:04", IntervalStyleSQLStandard, Interval{0, 1, 7384e6, IntervalPgPrecision}, false},
+		{"-1 2:03:04", IntervalStyleSQLStandard, Interval{0, -1, -7384e6, IntervalPgPrecision}, false},
+		{"-1 +2:03:04", IntervalStyleSQLStandard, Interval{0, -1, 7384e6, IntervalPgPrecision}, false},
+		{"-2:03:04", IntervalStyleSQLStandard, Interval{0, 0, -7384e6, IntervalPgPrecision}, false},
+		{"+1-2 -3 +4:05:06.789", IntervalStyleSQLStandard, Interval{14, -3, 14706789e3, IntervalPgPrecision}, false},
+		{"-1-2 +3 -4:05:06.789", IntervalStyleSQLStandard, Interval{-14, 3, -14706789e3, IntervalPgPrecision}, false},
+		{"+0-0 -1 +1:00:00", IntervalStyleSQLStandard, Interval{0, -1, 3600e6, IntervalPgPrecision}, false},
+		{"12:34.5", IntervalStyleSQLStandard, Interval{0, 0, 754500e3, IntervalPgPrecision}, false},
+		{"1:2", IntervalStyleSQLStandard, Interval{0, 0, 3720e6, IntervalPgPrecision}, false},
+		{"1-12", IntervalStyleSQLStandard, Interval{}, true},
+		{"1-2-3", IntervalStyleSQLStandard, Interval{}, true},
+		{"1/2", IntervalStyleSQLStandard, Interval{}, true},
+		{"1.5-2", IntervalStyleSQLStandard, Interval{}, true},
+		{"1:60:00", IntervalStyleSQLStandard, Interval{}, true},
+		{"1:00:61", IntervalStyleSQLStandard, Interval{}, true},
+		{"1:2:3:4", IntervalStyleSQLStandard, Interval{}, true},
+		{"12:34.5:1", IntervalStyleSQLStandard, Interval{}, true},
+		{"1 2 3", IntervalStyleSQLStandard, Interval{}, true},
+		{"", IntervalStyleSQLStandard, Interval{}, true},
+		{"1 # 2", IntervalStyleSQLStandard, Interval{}, true},
+		{"1 µs", IntervalStyleSQLStandard, Interval{}, true},
+		{"- 1", IntervalStyleSQLStandard, Interval{0, 0, -1e6, IntervalPgPrecision}, false},
+		{"-", IntervalStyleSQLStandard, Interval{}, true},
+		{"2147483648-0", IntervalStyleSQLStandard, Interval{}, true},
+		{"2147483647 days 1 day", IntervalStyleSQLStandard, Interval{}, true},
+		{"2147483648 days ago", IntervalStyleSQLStandard, Interval{0, -2147483648, 0, IntervalPgPrecision}, false},
+		{"2147483648 days", IntervalStyleSQLStandard, Interval{}, true},
+		{"99999999999999999999 secs", IntervalStyleSQLStandard, Interval{}, true},
+		{"9999999999:00:00", IntervalStyleSQLStandard, Interval{}, true},
+		// auto
+		{"1 year 2 mons -3 days +04:05:06.789", IntervalStyleAuto, Interval{14, -3, 14706789e3, IntervalPgPrecision}, false},
+		{"-1 days +04:05:06", IntervalStyleAuto, Interval{0, -1, 14706e6, IntervalPgPrecision}, false},
+		{"@ 1 year 2 mons -3 days 4 hours 5 mins 6.789 secs ago", IntervalStyleAuto, Interval{-14, 3, -14706789e3, IntervalPgPrecision}, false},
+		{"-1-2 +3 -4:05:06.789", IntervalStyleAuto, Interval{-14, 3, -14706789e3, IntervalPgPrecision}, false},
+		{"-1 2:03:04", IntervalStyleAuto, Interval{0, -1, -7384e6, IntervalPgPrecision}, false},
+		{"P1Y2M-3DT4H5M6.789S", IntervalStyleAuto, Interval{14, -3, 14706789e3, IntervalPgPrecision}, false},
+		{"P1X", IntervalStyleAuto, Interval{}, true},
+		{"1 X", IntervalStyleAuto, Interval{}, true},
+	}
+
+	for _, v := range test {
+		i, err := ParseIntervalWithStyle(v.s, IntervalPgPrecision, v.style)
+		if (err != nil) != v.err {
+			t.Errorf("%v,%v: expect error %v, got %v", v.s, v.style, v.err, err)
+		}
+		if !v.err && err == nil && i != v.i {
+			t.Errorf("%v,%v: expect %#v, got %#v", v.s, v.style, v.i, i)
+		}
+	}
+}
+
+func TestInterval_StringStyleQuick(t *testing.T) {
+	f := func(i Interval) bool {
+		for _, style := range intervalStyles {
+			s := i.StringStyle(style)
+			for _, ps := range []IntervalStyle{style, IntervalStyleAuto} {
+				if r, err := ParseIntervalWithStyle(s, i.precision, ps); err != nil || r != i {
+					t.Logf("%v,%v,%v: got %#v %v", s, style, ps, r, err)
+					return false
+				}
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, quickConfig(10000)); err != nil {
+		t.Error(err)
+	}
+}
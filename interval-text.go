@@ -0,0 +1,356 @@
+package pgtypes
+
+import (
+	"errors"
+	"github.com/apaxa-go/helper/mathh"
+	"github.com/apaxa-go/helper/strconvh"
+	"github.com/apaxa-go/helper/stringsh"
+	"github.com/apaxa-go/helper/timeh"
+	"math/big"
+	"strings"
+)
+
+var (
+	errIntervalOutOfRange   = errors.New("interval out of range")
+	errIntervalDivideByZero = errors.New("division by zero")
+)
+
+// errIntervalParse returns error for string s which is not a valid interval representation.
+// Offset is a byte offset in s where the problem was found.
+func errIntervalParse(s string, offset int, reason string) error {
+	return &ParseError{Type: "interval", Str: s, Offset: offset, Reason: reason}
+}
+
+// addInt64 returns a+b. ok is false if result overflows int64.
+func addInt64(a, b int64) (r int64, ok bool) {
+	r = a + b
+	return r, (r > a) == (b > 0)
+}
+
+// subInt64 returns a-b. ok is false if result overflows int64.
+func subInt64(a, b int64) (r int64, ok bool) {
+	r = a - b
+	return r, (r < a) == (b > 0)
+}
+
+// divRoundInt64 returns a/b rounded half away from zero. ok is false if result overflows int64 (MinInt64/-1).
+// b must not be zero.
+func divRoundInt64(a, b int64) (q int64, ok bool) {
+	if a == mathh.MinInt64 && b == -1 {
+		return 0, false
+	}
+	q, r := a/b, a%b
+	ur, ub := uint64(r), uint64(b)
+	if r < 0 {
+		ur = -ur
+	}
+	if b < 0 {
+		ub = -ub
+	}
+	if ur >= ub-ur && r != 0 {
+		if (a < 0) != (b < 0) {
+			q--
+		} else {
+			q++
+		}
+	}
+	return q, true
+}
+
+// mulInt64 returns a*b. ok is false if result overflows int64.
+func mulInt64(a, b int64) (r int64, ok bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	r = a * b
+	if r/b != a || (a == -1 && b == mathh.MinInt64) || (b == -1 && a == mathh.MinInt64) {
+		return 0, false
+	}
+	return r, true
+}
+
+// toInt32 converts a to int32. ok is false if a does not fit int32.
+func toInt32(a int64) (r int32, ok bool) {
+	return int32(a), a >= mathh.MinInt32 && a <= mathh.MaxInt32
+}
+
+// newIntervalChecked returns Interval with given parts and precision p.
+// It returns error if months or days part does not fit int32.
+func newIntervalChecked(months, days, someSeconds int64, p uint8) (Interval, error) {
+	m, ok1 := toInt32(months)
+	d, ok2 := toInt32(days)
+	if !ok1 || !ok2 {
+		return Interval{}, errIntervalOutOfRange
+	}
+	return Interval{Months: m, Days: d, SomeSeconds: someSeconds, precision: p}, nil
+}
+
+// bigInt64 returns x as int64. ok is false if x does not fit int64.
+func bigInt64(x *big.Int) (int64, bool) {
+	return x.Int64(), x.BitLen() < 64 || x.Sign() < 0 && x.Cmp(big.NewInt(mathh.MinInt64)) == 0
+}
+
+// ratRoundInt64 rounds r to integer. ok is false if result does not fit int64.
+// If halfEven is true r rounds half to even (as C rint does), otherwise it rounds half away from zero.
+func ratRoundInt64(r *big.Rat, halfEven bool) (int64, bool) {
+	q, m := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	switch m.Abs(m).Lsh(m, 1).Cmp(r.Denom()) {
+	case 1:
+		q.Add(q, big.NewInt(int64(r.Sign())))
+	case 0:
+		if !halfEven || q.Bit(0) == 1 {
+			q.Add(q, big.NewInt(int64(r.Sign())))
+		}
+	}
+	return bigInt64(q)
+}
+
+// ratTruncInt64 truncates r to integer. ok is false if result does not fit int64.
+func ratTruncInt64(r *big.Rat) (int64, bool) {
+	q := new(big.Int).Quo(r.Num(), r.Denom())
+	return bigInt64(q)
+}
+
+// intervalNumber is a decimal number from interval text representation.
+// Fraction part has the same sign as the whole number, it is nil if there is no fraction part.
+type intervalNumber struct {
+	ipart int64
+	frac  *big.Rat
+}
+
+// parseIntervalNumber parses decimal number in form "[+-]digits[.digits]" from the beginning of s and returns it with the rest of s.
+// Integer part or fraction part (but not both) may be omitted. Both point and comma are accepted as decimal mark.
+// ok is false if s does not begin with decimal number or if integer part overflows int64.
+func parseIntervalNumber(s string) (n intervalNumber, rest string, ok bool) {
+	i := 0
+	negative := false
+	if i < len(s) && (s[i] == '-' || s[i] == '+') {
+		negative = s[i] == '-'
+		i++
+	}
+
+	intFrom := i
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	intTo := i
+
+	fracFrom, fracTo := i, i
+	if i < len(s) && (s[i] == '.' || s[i] == ',') {
+		i++
+		fracFrom = i
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		fracTo = i
+	}
+
+	if intFrom == intTo && fracFrom == fracTo {
+		return intervalNumber{}, s, false
+	}
+
+	if intFrom != intTo {
+		// Magnitude is parsed as unsigned, so the minimal int64 value is accepted too
+		limit := uint64(mathh.MaxInt64)
+		if negative {
+			limit++
+		}
+		u, err := strconvh.ParseUint64(s[intFrom:intTo])
+		if err != nil || u > limit {
+			return intervalNumber{}, s, false
+		}
+		n.ipart = int64(u)
+		if negative {
+			n.ipart = -n.ipart
+		}
+	}
+
+	if fracDigits := stringsh.TrimRightBytes(s[fracFrom:fracTo], '0'); fracDigits != "" {
+		num, _ := new(big.Int).SetString(fracDigits, 10)
+		if negative {
+			num.Neg(num)
+		}
+		n.frac = new(big.Rat).SetFrac(num, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(len(fracDigits))), nil))
+	}
+
+	return n, s[i:], true
+}
+
+// intervalBuilder accumulates parts of Interval while parsing its text representation.
+// All additions are checked for overflow. After the first overflow all further additions are ignored.
+// If negative is true then all added values are negated (it is used for "ago").
+// Negating each value instead of the result allows to get MinInt64 and MinInt32 from the corresponding positive values.
+// Inexact is set if some fraction of second was rounded to the precision of Interval.
+type intervalBuilder struct {
+	i        Interval
+	negative bool
+	overflow bool
+	inexact  bool  // Fraction of second was rounded
+	digits   uint8 // Number of decimal digits required to represent all fractions of second exactly
+	mode     *intervalParseMode
+}
+
+// intervalParseMode configures parsing of fraction of second and receives information about it.
+type intervalParseMode struct {
+	exact  bool  // Return error instead of rounding fraction of second to precision
+	digits uint8 // Set on success: number of decimal digits required to represent all fractions of second exactly (IntervalMaxPrecision+1 if more)
+}
+
+// ratDecimalDigits returns number of decimal digits after decimal point required to represent r exactly.
+// It returns IntervalMaxPrecision+1 if more digits are required (or if r is not a finite decimal fraction).
+func ratDecimalDigits(r *big.Rat) uint8 {
+	pow := big.NewInt(1)
+	for n := uint8(0); n <= IntervalMaxPrecision; n++ {
+		if new(big.Int).Mod(pow, r.Denom()).Sign() == 0 {
+			return n
+		}
+		pow.Mul(pow, big.NewInt(10))
+	}
+	return IntervalMaxPrecision + 1
+}
+
+// scale returns v*mul (negated if required). ok is false if result overflows int64.
+func (b *intervalBuilder) scale(v, mul int64) (r int64, ok bool) {
+	if b.negative {
+		mul = -mul
+	}
+	return mulInt64(v, mul)
+}
+
+// scaleRat returns frac*mul (negated if required).
+func (b *intervalBuilder) scaleRat(frac *big.Rat, mul int64) *big.Rat {
+	if b.negative {
+		mul = -mul
+	}
+	return new(big.Rat).Mul(frac, new(big.Rat).SetInt64(mul))
+}
+
+// newIntervalBuilder returns builder for Interval with precision p.
+func newIntervalBuilder(p uint8) intervalBuilder {
+	return intervalBuilder{i: NewInterval(p)}
+}
+
+// result returns accumulated Interval or error if overflow happened.
+func (b *intervalBuilder) result() (Interval, error) {
+	if b.overflow {
+		return b.i, errIntervalOutOfRange
+	}
+	if b.mode != nil {
+		b.mode.digits = b.digits
+	}
+	return b.i, nil
+}
+
+// lostFraction returns true if fraction of second was rounded, but exact parsing is required.
+func (b *intervalBuilder) lostFraction() bool {
+	return b.inexact && b.mode != nil && b.mode.exact
+}
+
+// addMonths adds v*mul months.
+func (b *intervalBuilder) addMonths(v, mul int64) {
+	v, ok := b.scale(v, mul)
+	if ok {
+		v, ok = addInt64(int64(b.i.Months), v)
+	}
+	if ok {
+		b.i.Months, ok = toInt32(v)
+	}
+	b.overflow = b.overflow || !ok
+}
+
+// addDays adds v*mul days.
+func (b *intervalBuilder) addDays(v, mul int64) {
+	v, ok := b.scale(v, mul)
+	if ok {
+		v, ok = addInt64(int64(b.i.Days), v)
+	}
+	if ok {
+		b.i.Days, ok = toInt32(v)
+	}
+	b.overflow = b.overflow || !ok
+}
+
+// addSeconds adds v*mul seconds.
+func (b *intervalBuilder) addSeconds(v, mul int64) {
+	v, ok := b.scale(v, mul)
+	if ok {
+		v, ok = mulInt64(v, mathh.PowInt64(10, int64(b.i.precision)))
+	}
+	if ok {
+		b.i.SomeSeconds, ok = addInt64(b.i.SomeSeconds, v)
+	}
+	b.overflow = b.overflow || !ok
+}
+
+// addFracMonths adds frac*mul months rounded half to even (as PostgreSQL does with fractional years).
+func (b *intervalBuilder) addFracMonths(frac *big.Rat, mul int64) {
+	if frac == nil {
+		return
+	}
+	v, ok := ratRoundInt64(b.scaleRat(frac, mul), true)
+	if ok {
+		v, ok = addInt64(int64(b.i.Months), v)
+	}
+	if ok {
+		b.i.Months, ok = toInt32(v)
+	}
+	b.overflow = b.overflow || !ok
+}
+
+// addFracDays adds frac*mul days. Fractional part of days cascades into seconds part.
+func (b *intervalBuilder) addFracDays(frac *big.Rat, mul int64) {
+	if frac == nil {
+		return
+	}
+	frac = new(big.Rat).Mul(frac, new(big.Rat).SetInt64(mul))
+	v, ok := ratTruncInt64(frac)
+	if !ok {
+		b.overflow = true
+		return
+	}
+	b.addDays(v, 1)
+	b.addFracSeconds(frac.Sub(frac, new(big.Rat).SetInt64(v)), timeh.SecsInDay)
+}
+
+// addFracSeconds adds frac*mul seconds rounded half away from zero to the precision of Interval.
+func (b *intervalBuilder) addFracSeconds(frac *big.Rat, mul int64) {
+	if frac == nil {
+		return
+	}
+	frac = b.scaleRat(frac, mul)
+	b.digits = mathh.Max2Uint8(b.digits, ratDecimalDigits(frac))
+	frac.Mul(frac, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(b.i.precision)), nil)))
+	b.inexact = b.inexact || !frac.IsInt()
+	v, ok := ratRoundInt64(frac, false)
+	if ok {
+		b.i.SomeSeconds, ok = addInt64(b.i.SomeSeconds, v)
+	}
+	b.overflow = b.overflow || !ok
+}
+
+// intervalSecondsParts splits seconds part ss with precision p into absolute hours, minutes, seconds and fraction (in units of precision p) and sign.
+// It works correctly even for ss = MinInt64.
+func intervalSecondsParts(ss int64, p uint8) (negative bool, h, m, s, f uint64) {
+	negative = ss < 0
+	abs := uint64(ss)
+	if negative {
+		abs = uint64(-ss)
+	}
+	pow := uint64(mathh.PowInt64(10, int64(p)))
+	f = abs % pow
+	abs /= pow
+	s = abs % 60
+	abs /= 60
+	m = abs % 60
+	h = abs / 60
+	return
+}
+
+// formatIntervalFrac returns fraction f with precision p as decimal string with leading decimal point and without trailing zeros.
+// It returns empty string if f is zero.
+func formatIntervalFrac(f uint64, p uint8) string {
+	if f == 0 {
+		return ""
+	}
+	return "." + strings.TrimRight(stringsh.PadLeftWithByte(strconvh.FormatUint64(f), '0', int(p)), "0")
+}
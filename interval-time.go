@@ -0,0 +1,76 @@
+package pgtypes
+
+import (
+	"github.com/apaxa-go/helper/mathh"
+	"github.com/apaxa-go/helper/timeh"
+	"time"
+)
+
+// zoneOffset returns offset (in seconds east of UTC) of location loc at the given unix time.
+func zoneOffset(unix int64, loc *time.Location) int64 {
+	_, offset := time.Unix(unix, 0).In(loc).Zone()
+	return int64(offset)
+}
+
+// pgDate returns time with wall clock w (location of w is ignored) in location loc.
+// Wall clock time which does not exist or is ambiguous because of time zone transition is resolved in the same way as PostgreSQL does:
+// in a spring-forward transition offset before the transition is used (so "02:30" becomes "03:30" with daylight saving time),
+// in a fall-back transition offset after the transition is used (so "01:30" is treated as standard time).
+// Unlike it, time.Date does not guarantee which offset is used in such cases.
+func pgDate(w time.Time, loc *time.Location) time.Time {
+	y, m, d := w.Date()
+	wall := time.Date(y, m, d, w.Hour(), w.Minute(), w.Second(), 0, time.UTC).Unix()
+	// Offsets are less than a day, so transition (if any) is between these points
+	before := zoneOffset(wall-timeh.SecsInDay, loc)
+	after := zoneOffset(wall+timeh.SecsInDay, loc)
+	unix := wall - before
+	if u := wall - after; zoneOffset(u, loc) == after {
+		unix = u
+	}
+	return time.Unix(unix, int64(w.Nanosecond())).In(loc)
+}
+
+// addToIn adds interval i multiplied by sign (1 or -1) to t in the same way as PostgreSQL "timestamptz + interval" does with time zone loc.
+func (i Interval) addToIn(t time.Time, loc *time.Location, sign int64) time.Time {
+	r := t.In(loc)
+	if i.Months != 0 {
+		y, m, d := r.Date()
+		months := int64(y)*timeh.MonthsInYear + int64(m) - 1 + sign*int64(i.Months)
+		y, m = int(months/timeh.MonthsInYear), time.Month(months%timeh.MonthsInYear+1)
+		if m < time.January {
+			y, m = y-1, m+timeh.MonthsInYear
+		}
+		// Clamp day to the last day of month
+		if last := time.Date(y, m+1, 0, 0, 0, 0, 0, time.UTC).Day(); d > last {
+			d = last
+		}
+		r = pgDate(time.Date(y, m, d, r.Hour(), r.Minute(), r.Second(), r.Nanosecond(), time.UTC), loc)
+	}
+	if i.Days != 0 {
+		y, m, d := r.Date()
+		r = pgDate(time.Date(y, m, d+int(sign*int64(i.Days)), r.Hour(), r.Minute(), r.Second(), r.Nanosecond(), time.UTC), loc)
+	}
+	pow := mathh.PowInt64(10, int64(i.precision))
+	ns := someSecondsChangePrecision(i.SomeSeconds%pow, i.precision, IntervalNanosecondPrecision)
+	return time.Unix(r.Unix()+sign*(i.SomeSeconds/pow), int64(r.Nanosecond())+sign*ns).In(t.Location())
+}
+
+// AddToIn adds interval i to timestamp t in the same way as PostgreSQL "timestamptz + interval" does with time zone set to loc.
+// Unlike AddTo:
+//
+//	months part is added first, and if the day does not exist in the result month it is clamped to the last day of month, so "2021-01-31" + "1 mon" is "2021-02-28" (AddTo returns "2021-03-03");
+//	months and days parts are added to wall clock time in location loc, so "1 day" keeps local time of day across daylight saving time transitions;
+//	seconds part is added as exact elapsed time.
+//
+// Nonexistent and ambiguous local times are resolved in the same way as PostgreSQL does.
+// Use time.UTC as loc to get the same result as PostgreSQL "timestamp + interval".
+// Result is in the location of t.
+func (i Interval) AddToIn(t time.Time, loc *time.Location) time.Time {
+	return i.addToIn(t, loc, 1)
+}
+
+// SubFromIn subtracts interval i from timestamp t in the same way as PostgreSQL "timestamptz - interval" does with time zone set to loc.
+// It is the same as adding negated i with AddToIn, so "2021-03-31" - "1 mon" is "2021-02-28".
+func (i Interval) SubFromIn(t time.Time, loc *time.Location) time.Time {
+	return i.addToIn(t, loc, -1)
+}
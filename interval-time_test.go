@@ -0,0 +1,91 @@
+package pgtypes
+
+import (
+	"testing"
+	"time"
+)
+
+func TestInterval_AddToIn(t *testing.T) {
+	type testElement struct {
+		i   Interval
+		t   time.Time
+		loc *time.Location
+		r   time.Time
+	}
+
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone database is not available:", err)
+	}
+	const p = IntervalMicrosecondPrecision
+	const h = 3600 * 1e6
+	utc := func(y int, m time.Month, d, hh, mm int) time.Time { return time.Date(y, m, d, hh, mm, 0, 0, time.UTC) }
+	// Results are the same as in PostgreSQL
+	test := []testElement{
+		{Interval{1, 0, 0, p}, utc(2021, 1, 31, 10, 0), time.UTC, utc(2021, 2, 28, 10, 0)},
+		{Interval{1, 0, 0, p}, utc(2020, 1, 31, 10, 0), time.UTC, utc(2020, 2, 29, 10, 0)},
+		{Interval{1, 1, 0, p}, utc(2021, 1, 31, 10, 0), time.UTC, utc(2021, 3, 1, 10, 0)},
+		{Interval{-1, 0, 0, p}, utc(2021, 3, 31, 10, 0), time.UTC, utc(2021, 2, 28, 10, 0)},
+		{Interval{-13, 0, 0, p}, utc(2021, 1, 15, 10, 0), time.UTC, utc(2019, 12, 15, 10, 0)},
+		{Interval{12, 0, 0, p}, utc(2020, 2, 29, 10, 0), time.UTC, utc(2021, 2, 28, 10, 0)},
+		{Interval{0, 0, 1500, IntervalMillisecondPrecision}, utc(2021, 1, 1, 0, 0), time.UTC, utc(2021, 1, 1, 0, 0).Add(1500 * time.Millisecond)},
+		{Interval{0, 0, -1, IntervalNanosecondPrecision}, utc(2021, 1, 1, 0, 0), time.UTC, utc(2021, 1, 1, 0, 0).Add(-1)},
+		// 2021-03-13 12:00 EST + 1 day = 2021-03-14 12:00 EDT
+		{Interval{0, 1, 0, p}, utc(2021, 3, 13, 17, 0), ny, utc(2021, 3, 14, 16, 0)},
+		{Interval{0, 0, 24 * h, p}, utc(2021, 3, 13, 17, 0), ny, utc(2021, 3, 14, 17, 0)},
+		// 2021-02-14 12:00 EST + 1 mon = 2021-03-14 12:00 EDT
+		{Interval{1, 0, 0, p}, utc(2021, 2, 14, 17, 0), ny, utc(2021, 3, 14, 16, 0)},
+		// 2021-03-13 02:30 EST + 1 day = 2021-03-14 03:30 EDT (02:30 does not exist)
+		{Interval{0, 1, 0, p}, utc(2021, 3, 13, 7, 30), ny, utc(2021, 3, 14, 7, 30)},
+		// 2021-11-06 01:30 EDT + 1 day = 2021-11-07 01:30 EST (01:30 is ambiguous)
+		{Interval{0, 1, 0, p}, utc(2021, 11, 6, 5, 30), ny, utc(2021, 11, 7, 6, 30)},
+		// 2021-11-06 12:00 EDT + 1 day 1 hour = 2021-11-07 13:00 EST
+		{Interval{0, 1, h, p}, utc(2021, 11, 6, 16, 0), ny, utc(2021, 11, 7, 18, 0)},
+		// 2021-01-31 22:00 EST (2021-02-01 in UTC) + 1 mon = 2021-02-28 22:00 EST
+		{Interval{1, 0, 0, p}, utc(2021, 2, 1, 3, 0), ny, utc(2021, 3, 1, 3, 0)},
+		{Interval{1, 0, 0, p}, utc(2021, 2, 1, 3, 0), time.UTC, utc(2021, 3, 1, 3, 0)},
+		{Interval{0, 30, 0, p}, utc(2021, 2, 1, 3, 0), ny, utc(2021, 3, 3, 3, 0)},
+	}
+
+	for _, v := range test {
+		if r := v.i.AddToIn(v.t, v.loc); !r.Equal(v.r) {
+			t.Errorf("%v + %v in %v: expect %v, got %v", v.t, v.i, v.loc, v.r, r)
+		}
+		if r := v.i.AddToIn(v.t.In(v.loc), v.loc); r.Location() != v.loc {
+			t.Errorf("%v + %v in %v: expect location %v, got %v", v.t, v.i, v.loc, v.loc, r.Location())
+		}
+	}
+}
+
+func TestInterval_SubFromIn(t *testing.T) {
+	type testElement struct {
+		i   Interval
+		t   time.Time
+		loc *time.Location
+		r   time.Time
+	}
+
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone database is not available:", err)
+	}
+	const p = IntervalMicrosecondPrecision
+	utc := func(y int, m time.Month, d, hh, mm int) time.Time { return time.Date(y, m, d, hh, mm, 0, 0, time.UTC) }
+	// Results are the same as in PostgreSQL
+	test := []testElement{
+		{Interval{1, 0, 0, p}, utc(2021, 3, 31, 10, 0), time.UTC, utc(2021, 2, 28, 10, 0)},
+		{Interval{-1, 0, 0, p}, utc(2021, 1, 31, 10, 0), time.UTC, utc(2021, 2, 28, 10, 0)},
+		{Interval{0, 1, 3600 * 1e6, p}, utc(2021, 3, 1, 0, 0), time.UTC, utc(2021, 2, 27, 23, 0)},
+		{Interval{0, 0, 1, IntervalSecondPrecision}, utc(2021, 1, 1, 0, 0), time.UTC, utc(2020, 12, 31, 23, 59).Add(59 * time.Second)},
+		// 2021-03-14 12:00 EDT - 1 day = 2021-03-13 12:00 EST
+		{Interval{0, 1, 0, p}, utc(2021, 3, 14, 16, 0), ny, utc(2021, 3, 13, 17, 0)},
+		// 2021-04-14 02:30 EDT - 1 mon = 2021-03-14 03:30 EDT (02:30 does not exist)
+		{Interval{1, 0, 0, p}, utc(2021, 4, 14, 6, 30), ny, utc(2021, 3, 14, 7, 30)},
+	}
+
+	for _, v := range test {
+		if r := v.i.SubFromIn(v.t, v.loc); !r.Equal(v.r) {
+			t.Errorf("%v - %v in %v: expect %v, got %v", v.t, v.i, v.loc, v.r, r)
+		}
+	}
+}
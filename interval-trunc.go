@@ -0,0 +1,132 @@
+package pgtypes
+
+import (
+	"github.com/apaxa-go/helper/mathh"
+	"github.com/apaxa-go/helper/timeh"
+	"math/big"
+)
+
+// Truncate returns interval with all fields less significant than the given unit set to zero in the same way as PostgreSQL date_trunc does.
+// Fields are truncated toward zero independently, so "1 day 25:40:00" truncated to days is "1 day" and "-1 year -5 mons" truncated to quarters is "-1 year -3 mons".
+// Units less than precision of interval do not change it.
+// It returns error if unit is IntervalUnitEpoch or unknown.
+func (i Interval) Truncate(unit IntervalUnit) (Interval, error) {
+	years, months := i.Months/timeh.MonthsInYear, i.Months%timeh.MonthsInYear
+	trunc := func(v, m int64) int64 { return v / m * m }
+	pow := mathh.PowInt64(10, int64(i.precision))
+
+	switch unit {
+	case IntervalUnitMillennium:
+		years = years / 1000 * 1000
+		fallthrough
+	case IntervalUnitCentury:
+		years = years / 100 * 100
+		fallthrough
+	case IntervalUnitDecade:
+		years = years / 10 * 10
+		fallthrough
+	case IntervalUnitYear:
+		months = 0
+		fallthrough
+	case IntervalUnitQuarter:
+		months = months / 3 * 3
+		fallthrough
+	case IntervalUnitMonth:
+		i.Days = 0
+		fallthrough
+	case IntervalUnitDay:
+		i.Months = years*timeh.MonthsInYear + months
+		i.SomeSeconds = 0
+	case IntervalUnitHour:
+		i.SomeSeconds = trunc(i.SomeSeconds, timeh.SecsInHour*pow)
+	case IntervalUnitMinute:
+		i.SomeSeconds = trunc(i.SomeSeconds, timeh.SecsInMin*pow)
+	case IntervalUnitSecond:
+		i.SomeSeconds = trunc(i.SomeSeconds, pow)
+	case IntervalUnitMillisecond:
+		if i.precision > 3 {
+			i.SomeSeconds = trunc(i.SomeSeconds, mathh.PowInt64(10, int64(i.precision-3)))
+		}
+	case IntervalUnitMicrosecond:
+		if i.precision > 6 {
+			i.SomeSeconds = trunc(i.SomeSeconds, mathh.PowInt64(10, int64(i.precision-6)))
+		}
+	default:
+		return Interval{}, errIntervalUnitNotSupported(unit)
+	}
+	return i, nil
+}
+
+// intervalUnitSize returns size of unit in seconds (with 30 days per month and 24 hours per day) and the same size as Interval with precision p.
+// Interval is valid only if unit is not less than precision.
+func intervalUnitSize(unit IntervalUnit, p uint8) (*big.Rat, Interval) {
+	pow := mathh.PowInt64(10, int64(p))
+	i := NewInterval(p)
+	var secs *big.Rat
+	switch unit {
+	case IntervalUnitMicrosecond:
+		secs = big.NewRat(1, 1e6)
+		if p >= 6 {
+			i.SomeSeconds = pow / 1e6
+		}
+	case IntervalUnitMillisecond:
+		secs = big.NewRat(1, 1e3)
+		if p >= 3 {
+			i.SomeSeconds = pow / 1e3
+		}
+	case IntervalUnitSecond:
+		secs, i.SomeSeconds = big.NewRat(1, 1), pow
+	case IntervalUnitMinute:
+		secs, i.SomeSeconds = big.NewRat(timeh.SecsInMin, 1), timeh.SecsInMin*pow
+	case IntervalUnitHour:
+		secs, i.SomeSeconds = big.NewRat(timeh.SecsInHour, 1), timeh.SecsInHour*pow
+	case IntervalUnitDay:
+		secs, i.Days = big.NewRat(timeh.SecsInDay, 1), 1
+	case IntervalUnitMonth:
+		i.Months = 1
+	case IntervalUnitQuarter:
+		i.Months = 3
+	case IntervalUnitYear:
+		i.Months = timeh.MonthsInYear
+	case IntervalUnitDecade:
+		i.Months = 10 * timeh.MonthsInYear
+	case IntervalUnitCentury:
+		i.Months = 100 * timeh.MonthsInYear
+	case IntervalUnitMillennium:
+		i.Months = 1000 * timeh.MonthsInYear
+	}
+	if i.Months != 0 {
+		secs = big.NewRat(int64(i.Months)*timeh.DaysInMonth*timeh.SecsInDay, 1)
+	}
+	return secs, i
+}
+
+// Round returns interval rounded to the nearest multiple of the given unit.
+// Result is the same as Truncate(unit) plus discarded fields rounded half away from zero to the whole number of units.
+// Discarded fields are converted to units using 30 days per month and 24 hours per day, so "1 day 25:40:00" rounded to days is "2 days" and "1 mon 16 days" rounded to months is "2 mons".
+// It returns error if unit is IntervalUnitEpoch or unknown or if result overflows.
+func (i Interval) Round(unit IntervalUnit) (Interval, error) {
+	t, err := i.Truncate(unit)
+	if err != nil {
+		return Interval{}, err
+	}
+
+	// Discarded parts, it is impossible to overflow because truncated parts have the same sign as original parts and not greater absolute values
+	rem := i.Sub(t)
+	remSecs := new(big.Rat).SetFrac(big.NewInt(rem.SomeSeconds), big.NewInt(mathh.PowInt64(10, int64(i.precision))))
+	remSecs.Add(remSecs, big.NewRat((int64(rem.Months)*timeh.DaysInMonth+int64(rem.Days))*timeh.SecsInDay, 1))
+	unitSecs, unitInterval := intervalUnitSize(unit, i.precision)
+
+	n, ok := ratRoundInt64(remSecs.Quo(remSecs, unitSecs), false)
+	if !ok {
+		return Interval{}, errIntervalOutOfRange
+	}
+	if n == 0 {
+		return t, nil
+	}
+	add, err := unitInterval.MulChecked(n)
+	if err != nil {
+		return Interval{}, err
+	}
+	return t.AddChecked(add)
+}
@@ -0,0 +1,108 @@
+package pgtypes
+
+import (
+	"github.com/apaxa-go/helper/mathh"
+	"testing"
+)
+
+func TestInterval_Truncate(t *testing.T) {
+	type testElement struct {
+		i    Interval
+		unit IntervalUnit
+		r    Interval
+		err  bool
+	}
+
+	const p = IntervalMicrosecondPrecision
+	const sec = 1e6
+	i1 := Interval{17, 20, (25*3600 + 40*60 + 30) * sec, p} // 1 year 5 mons 20 days 25:40:30
+	i2 := Interval{-17, -20, -(3723*sec + 456789), p}       // -1 year -5 mons -20 days -01:02:03.456789
+	i3 := Interval{2517*12 + 5, 0, 0, p}                    // 2517 years 5 mons
+	// Results are the same as in PostgreSQL
+	test := []testElement{
+		{i1, IntervalUnitMicrosecond, i1, false},
+		{i1, IntervalUnitSecond, i1, false},
+		{i1, IntervalUnitMinute, Interval{17, 20, (25*3600 + 40*60) * sec, p}, false},
+		{i1, IntervalUnitHour, Interval{17, 20, 25 * 3600 * sec, p}, false},
+		{i1, IntervalUnitDay, Interval{17, 20, 0, p}, false},
+		{i1, IntervalUnitMonth, Interval{17, 0, 0, p}, false},
+		{i1, IntervalUnitQuarter, Interval{15, 0, 0, p}, false},
+		{i1, IntervalUnitYear, Interval{12, 0, 0, p}, false},
+		{i1, IntervalUnitDecade, Interval{0, 0, 0, p}, false},
+		{i2, IntervalUnitMicrosecond, i2, false},
+		{i2, IntervalUnitMillisecond, Interval{-17, -20, -(3723*sec + 456000), p}, false},
+		{i2, IntervalUnitSecond, Interval{-17, -20, -3723 * sec, p}, false},
+		{i2, IntervalUnitMinute, Interval{-17, -20, -3720 * sec, p}, false},
+		{i2, IntervalUnitHour, Interval{-17, -20, -3600 * sec, p}, false},
+		{i2, IntervalUnitDay, Interval{-17, -20, 0, p}, false},
+		{i2, IntervalUnitMonth, Interval{-17, 0, 0, p}, false},
+		{i2, IntervalUnitQuarter, Interval{-15, 0, 0, p}, false},
+		{i2, IntervalUnitYear, Interval{-12, 0, 0, p}, false},
+		{i3, IntervalUnitDecade, Interval{2510 * 12, 0, 0, p}, false},
+		{i3, IntervalUnitCentury, Interval{2500 * 12, 0, 0, p}, false},
+		{i3, IntervalUnitMillennium, Interval{2000 * 12, 0, 0, p}, false},
+		{Interval{0, 0, 1234567891, IntervalNanosecondPrecision}, IntervalUnitMicrosecond, Interval{0, 0, 1234567000, IntervalNanosecondPrecision}, false},
+		{Interval{0, 0, 1234, IntervalMillisecondPrecision}, IntervalUnitMicrosecond, Interval{0, 0, 1234, IntervalMillisecondPrecision}, false},
+		{Interval{0, 0, 12, IntervalSecondPrecision}, IntervalUnitMillisecond, Interval{0, 0, 12, IntervalSecondPrecision}, false},
+		{Interval{0, 0, mathh.MinInt64, p}, IntervalUnitHour, Interval{0, 0, -2562047788 * 3600 * sec, p}, false},
+		{i1, IntervalUnitEpoch, Interval{}, true},
+		{i1, IntervalUnit(100), Interval{}, true},
+	}
+
+	for _, v := range test {
+		r, err := v.i.Truncate(v.unit)
+		if (err != nil) != v.err || !v.err && r != v.r {
+			t.Errorf("%v,%v: expect %v %v, got %v %v", v.i, v.unit, v.r, v.err, r, err)
+		}
+	}
+}
+
+func TestInterval_Round(t *testing.T) {
+	type testElement struct {
+		i    Interval
+		unit IntervalUnit
+		r    Interval
+		err  bool
+	}
+
+	const p = IntervalMicrosecondPrecision
+	const sec = 1e6
+	const h = 3600 * sec
+	test := []testElement{
+		{Interval{0, 1, 25*h + 40*60*sec, p}, IntervalUnitHour, Interval{0, 1, 26 * h, p}, false},
+		{Interval{0, 0, -30 * 60 * sec, p}, IntervalUnitHour, Interval{0, 0, -h, p}, false},
+		{Interval{0, 0, 30*60*sec - 1, p}, IntervalUnitHour, Interval{0, 0, 0, p}, false},
+		{Interval{0, 0, 90 * sec, p}, IntervalUnitMinute, Interval{0, 0, 120 * sec, p}, false},
+		{Interval{0, 0, 1500000, p}, IntervalUnitSecond, Interval{0, 0, 2 * sec, p}, false},
+		{Interval{0, 0, 1500, p}, IntervalUnitMillisecond, Interval{0, 0, 2000, p}, false},
+		{Interval{0, 0, -1500, p}, IntervalUnitMillisecond, Interval{0, 0, -2000, p}, false},
+		{Interval{0, 0, 1500, IntervalNanosecondPrecision}, IntervalUnitMicrosecond, Interval{0, 0, 2000, IntervalNanosecondPrecision}, false},
+		{Interval{0, 0, 15, IntervalMillisecondPrecision}, IntervalUnitMicrosecond, Interval{0, 0, 15, IntervalMillisecondPrecision}, false},
+		{Interval{0, 1, 25*h + 40*60*sec, p}, IntervalUnitDay, Interval{0, 2, 0, p}, false},
+		{Interval{0, 1, 50 * h, p}, IntervalUnitDay, Interval{0, 3, 0, p}, false},
+		{Interval{0, 1, -11 * h, p}, IntervalUnitDay, Interval{0, 1, 0, p}, false},
+		{Interval{0, 1, -12 * h, p}, IntervalUnitDay, Interval{0, 0, 0, p}, false},
+		{Interval{1, 16, 0, p}, IntervalUnitMonth, Interval{2, 0, 0, p}, false},
+		{Interval{1, 15, 0, p}, IntervalUnitMonth, Interval{2, 0, 0, p}, false},
+		{Interval{1, 14, 23 * h, p}, IntervalUnitMonth, Interval{1, 0, 0, p}, false},
+		{Interval{1, 100, 0, p}, IntervalUnitMonth, Interval{4, 0, 0, p}, false},
+		{Interval{4, 15, 0, p}, IntervalUnitQuarter, Interval{6, 0, 0, p}, false},
+		{Interval{18, 0, 0, p}, IntervalUnitYear, Interval{24, 0, 0, p}, false},
+		{Interval{-18, 0, 0, p}, IntervalUnitYear, Interval{-24, 0, 0, p}, false},
+		{Interval{17, 0, 0, p}, IntervalUnitYear, Interval{12, 0, 0, p}, false},
+		{Interval{2517 * 12, 0, 0, p}, IntervalUnitDecade, Interval{2520 * 12, 0, 0, p}, false},
+		{Interval{2517 * 12, 0, 0, p}, IntervalUnitCentury, Interval{2500 * 12, 0, 0, p}, false},
+		{Interval{2517 * 12, 0, 0, p}, IntervalUnitMillennium, Interval{3000 * 12, 0, 0, p}, false},
+		{Interval{0, 0, mathh.MaxInt64, IntervalSecondPrecision}, IntervalUnitDay, Interval{}, true},
+		{Interval{0, 0, mathh.MaxInt64, IntervalSecondPrecision}, IntervalUnitHour, Interval{}, true},
+		{Interval{mathh.MaxInt32, 0, 0, p}, IntervalUnitYear, Interval{}, true},
+		{Interval{0, 0, 0, p}, IntervalUnitEpoch, Interval{}, true},
+	}
+
+	for _, v := range test {
+		r, err := v.i.Round(v.unit)
+		if (err != nil) != v.err || !v.err && r != v.r {
+			t.Errorf("%v,%v: expect %v %v, got %v %v", v.i, v.unit, v.r, v.err, r, err)
+		}
+	}
+}
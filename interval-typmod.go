@@ -0,0 +1,150 @@
+package pgtypes
+
+import (
+	"errors"
+	"github.com/apaxa-go/helper/mathh"
+	"github.com/apaxa-go/helper/strconvh"
+	"github.com/apaxa-go/helper/timeh"
+	"strings"
+)
+
+// IntervalFields is a set of fields of interval type as in PostgreSQL type declaration (e.g. "interval day to second").
+// Values are the same as used by PostgreSQL in interval typmod.
+type IntervalFields uint16
+
+// Possible interval fields.
+const (
+	IntervalFieldsMonth  IntervalFields = 1 << 1
+	IntervalFieldsYear   IntervalFields = 1 << 2
+	IntervalFieldsDay    IntervalFields = 1 << 3
+	IntervalFieldsHour   IntervalFields = 1 << 10
+	IntervalFieldsMinute IntervalFields = 1 << 11
+	IntervalFieldsSecond IntervalFields = 1 << 12
+
+	IntervalFieldsYearToMonth    = IntervalFieldsYear | IntervalFieldsMonth
+	IntervalFieldsDayToHour      = IntervalFieldsDay | IntervalFieldsHour
+	IntervalFieldsDayToMinute    = IntervalFieldsDay | IntervalFieldsHour | IntervalFieldsMinute
+	IntervalFieldsDayToSecond    = IntervalFieldsDay | IntervalFieldsHour | IntervalFieldsMinute | IntervalFieldsSecond
+	IntervalFieldsHourToMinute   = IntervalFieldsHour | IntervalFieldsMinute
+	IntervalFieldsHourToSecond   = IntervalFieldsHour | IntervalFieldsMinute | IntervalFieldsSecond
+	IntervalFieldsMinuteToSecond = IntervalFieldsMinute | IntervalFieldsSecond
+
+	IntervalFieldsAll IntervalFields = 0x7FFF // No restriction
+)
+
+// IntervalFullPrecision means no restriction of seconds precision in interval typmod.
+const IntervalFullPrecision = 0xFFFF
+
+var (
+	errIntervalFields    = errors.New("unrecognized interval fields")
+	errIntervalPrecision = errors.New("interval precision must not be negative")
+)
+
+// intervalFieldsNames contains names of all valid interval fields (except IntervalFieldsAll).
+var intervalFieldsNames = map[IntervalFields]string{
+	IntervalFieldsYear:           "year",
+	IntervalFieldsMonth:          "month",
+	IntervalFieldsDay:            "day",
+	IntervalFieldsHour:           "hour",
+	IntervalFieldsMinute:         "minute",
+	IntervalFieldsSecond:         "second",
+	IntervalFieldsYearToMonth:    "year to month",
+	IntervalFieldsDayToHour:      "day to hour",
+	IntervalFieldsDayToMinute:    "day to minute",
+	IntervalFieldsDayToSecond:    "day to second",
+	IntervalFieldsHourToMinute:   "hour to minute",
+	IntervalFieldsHourToSecond:   "hour to second",
+	IntervalFieldsMinuteToSecond: "minute to second",
+}
+
+// String returns fields as in PostgreSQL type declaration, e.g. "day to second".
+// It returns empty string for IntervalFieldsAll.
+func (f IntervalFields) String() string {
+	if f == IntervalFieldsAll {
+		return ""
+	}
+	if s, ok := intervalFieldsNames[f]; ok {
+		return s
+	}
+	return "IntervalFields(" + strconvh.FormatUint16(uint16(f)) + ")"
+}
+
+// ParseIntervalFields parses fields as in PostgreSQL type declaration, e.g. "day to second" or "YEAR".
+// Empty string means IntervalFieldsAll. ok is false if s is not a valid fields declaration.
+func ParseIntervalFields(s string) (f IntervalFields, ok bool) {
+	s = strings.ToLower(strings.Join(strings.Fields(s), " "))
+	if s == "" {
+		return IntervalFieldsAll, true
+	}
+	for k, v := range intervalFieldsNames {
+		if v == s {
+			return k, true
+		}
+	}
+	return 0, false
+}
+
+// EncodeIntervalTypmod returns PostgreSQL typmod for interval type with given fields and seconds precision.
+// Pass IntervalFullPrecision as precision if it is not restricted.
+func EncodeIntervalTypmod(fields IntervalFields, precision int) int32 {
+	return int32(fields)<<16 | int32(precision&0xFFFF)
+}
+
+// DecodeIntervalTypmod returns fields and seconds precision from PostgreSQL typmod of interval type (e.g. pg_attribute.atttypmod).
+// Typmod -1 (no typmod) is decoded as IntervalFieldsAll and IntervalFullPrecision.
+func DecodeIntervalTypmod(typmod int32) (fields IntervalFields, precision int) {
+	if typmod < 0 {
+		return IntervalFieldsAll, IntervalFullPrecision
+	}
+	return IntervalFields(typmod >> 16 & 0x7FFF), int(typmod & 0xFFFF)
+}
+
+// ApplyTypmod returns interval adjusted to the PostgreSQL column type with given fields and seconds precision in the same way as PostgreSQL does it while storing value.
+// Fields less than the smallest one in fields are truncated (except that "year" keeps whole years of months part only), larger fields are kept,
+// so "1 year 2 days 03:04:05" as "hour" is "1 year 2 days 03:00:00".
+// Then seconds part is rounded half away from zero to precision digits after decimal point.
+// Precision IntervalFullPrecision (or any precision not less than precision of i) means no rounding,
+// other precisions greater than IntervalPgPrecision are reduced to IntervalPgPrecision (as PostgreSQL does).
+// Result has the same precision as i. Comparing result with i allows to check if value will be stored without changes.
+// It returns error if fields is not valid, if precision is negative or if rounding overflows.
+func (i Interval) ApplyTypmod(fields IntervalFields, precision int) (Interval, error) {
+	if precision < 0 {
+		return Interval{}, errIntervalPrecision
+	}
+	if precision > IntervalPgPrecision && precision != IntervalFullPrecision {
+		precision = IntervalPgPrecision
+	}
+
+	var unit int64 // Truncate seconds part to this number of seconds, 0 means no truncation
+	switch fields {
+	case IntervalFieldsAll, IntervalFieldsSecond, IntervalFieldsDayToSecond, IntervalFieldsHourToSecond, IntervalFieldsMinuteToSecond:
+	case IntervalFieldsYear:
+		i = Interval{Months: i.Months / timeh.MonthsInYear * timeh.MonthsInYear, precision: i.precision}
+	case IntervalFieldsMonth, IntervalFieldsYearToMonth:
+		i = Interval{Months: i.Months, precision: i.precision}
+	case IntervalFieldsDay:
+		i.SomeSeconds = 0
+	case IntervalFieldsHour, IntervalFieldsDayToHour:
+		unit = timeh.SecsInHour
+	case IntervalFieldsMinute, IntervalFieldsDayToMinute, IntervalFieldsHourToMinute:
+		unit = timeh.SecsInMin
+	default:
+		return Interval{}, errIntervalFields
+	}
+	if unit != 0 {
+		unit *= mathh.PowInt64(10, int64(i.precision))
+		i.SomeSeconds = i.SomeSeconds / unit * unit
+	}
+
+	if precision < int(i.precision) {
+		ss, ok := divRoundInt64(i.SomeSeconds, mathh.PowInt64(10, int64(int(i.precision)-precision)))
+		if ok {
+			ss, ok = mulInt64(ss, mathh.PowInt64(10, int64(int(i.precision)-precision)))
+		}
+		if !ok {
+			return Interval{}, errIntervalOutOfRange
+		}
+		i.SomeSeconds = ss
+	}
+	return i, nil
+}
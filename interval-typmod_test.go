@@ -0,0 +1,123 @@
+package pgtypes
+
+import (
+	"github.com/apaxa-go/helper/mathh"
+	"testing"
+)
+
+func TestInterval_ApplyTypmod(t *testing.T) {
+	type testElement struct {
+		i         Interval
+		fields    IntervalFields
+		precision int
+		r         Interval
+		err       bool
+	}
+
+	const p = IntervalMicrosecondPrecision
+	i1 := Interval{14, 3, 14706789 * 1e3, p}    // 1 year 2 mons 3 days 04:05:06.789
+	i2 := Interval{-17, -3, -14706789 * 1e3, p} // -1 year -5 mons -3 days -04:05:06.789
+	// Results are the same as in PostgreSQL
+	test := []testElement{
+		{i1, IntervalFieldsAll, IntervalFullPrecision, i1, false},
+		{i1, IntervalFieldsYear, IntervalFullPrecision, Interval{12, 0, 0, p}, false},
+		{i1, IntervalFieldsMonth, IntervalFullPrecision, Interval{14, 0, 0, p}, false},
+		{i1, IntervalFieldsYearToMonth, IntervalFullPrecision, Interval{14, 0, 0, p}, false},
+		{i1, IntervalFieldsDay, IntervalFullPrecision, Interval{14, 3, 0, p}, false},
+		{i1, IntervalFieldsHour, IntervalFullPrecision, Interval{14, 3, 14400 * 1e6, p}, false},
+		{i1, IntervalFieldsDayToHour, IntervalFullPrecision, Interval{14, 3, 14400 * 1e6, p}, false},
+		{i1, IntervalFieldsMinute, IntervalFullPrecision, Interval{14, 3, 14700 * 1e6, p}, false},
+		{i1, IntervalFieldsDayToMinute, IntervalFullPrecision, Interval{14, 3, 14700 * 1e6, p}, false},
+		{i1, IntervalFieldsHourToMinute, IntervalFullPrecision, Interval{14, 3, 14700 * 1e6, p}, false},
+		{i1, IntervalFieldsSecond, IntervalFullPrecision, i1, false},
+		{i1, IntervalFieldsSecond, 1, Interval{14, 3, 14706800 * 1e3, p}, false},
+		{i1, IntervalFieldsDayToSecond, 0, Interval{14, 3, 14707 * 1e6, p}, false},
+		{i1, IntervalFieldsHourToSecond, 2, Interval{14, 3, 14706790 * 1e3, p}, false},
+		{i1, IntervalFieldsMinuteToSecond, 6, i1, false},
+		{i1, IntervalFieldsAll, 3, i1, false},
+		{i1, IntervalFieldsMinute, 0, Interval{14, 3, 14700 * 1e6, p}, false},
+		{i2, IntervalFieldsYear, IntervalFullPrecision, Interval{-12, 0, 0, p}, false},
+		{i2, IntervalFieldsHour, IntervalFullPrecision, Interval{-17, -3, -14400 * 1e6, p}, false},
+		{i2, IntervalFieldsSecond, 0, Interval{-17, -3, -14707 * 1e6, p}, false},
+		{Interval{0, 0, -450000, p}, IntervalFieldsSecond, 0, Interval{0, 0, 0, p}, false},
+		{Interval{0, 0, -500000, p}, IntervalFieldsSecond, 0, Interval{0, 0, -1e6, p}, false},
+		{Interval{0, 0, 1234567891, IntervalNanosecondPrecision}, IntervalFieldsAll, 6, Interval{0, 0, 1234568000, IntervalNanosecondPrecision}, false},
+		{Interval{0, 0, 1234, IntervalMillisecondPrecision}, IntervalFieldsAll, 6, Interval{0, 0, 1234, IntervalMillisecondPrecision}, false},
+		{Interval{0, 0, mathh.MaxInt64, p}, IntervalFieldsAll, 0, Interval{}, true},
+		{Interval{0, 0, mathh.MinInt64, p}, IntervalFieldsAll, 0, Interval{}, true},
+		{Interval{0, 0, mathh.MaxInt64, p}, IntervalFieldsAll, 5, Interval{}, true},
+		{Interval{0, 0, mathh.MaxInt64 - 8, p}, IntervalFieldsAll, 5, Interval{0, 0, mathh.MaxInt64 - 7, p}, false},
+		{i1, IntervalFieldsYear | IntervalFieldsDay, IntervalFullPrecision, Interval{}, true},
+		{i1, 0, IntervalFullPrecision, Interval{}, true},
+		{i1, IntervalFieldsAll, -1, Interval{}, true},
+		{Interval{0, 0, 1234567891, IntervalNanosecondPrecision}, IntervalFieldsAll, 7, Interval{0, 0, 1234568000, IntervalNanosecondPrecision}, false},
+		{Interval{0, 0, 1234567891, IntervalNanosecondPrecision}, IntervalFieldsAll, 65534, Interval{0, 0, 1234568000, IntervalNanosecondPrecision}, false},
+		{Interval{0, 0, 1234567891, IntervalNanosecondPrecision}, IntervalFieldsAll, IntervalFullPrecision, Interval{0, 0, 1234567891, IntervalNanosecondPrecision}, false},
+	}
+
+	for _, v := range test {
+		r, err := v.i.ApplyTypmod(v.fields, v.precision)
+		if (err != nil) != v.err || !v.err && r != v.r {
+			t.Errorf("%v,%v,%v: expect %#v %v, got %#v %v", v.i, v.fields, v.precision, v.r, v.err, r, err)
+		}
+	}
+}
+
+func TestIntervalTypmod(t *testing.T) {
+	type testElement struct {
+		fields    IntervalFields
+		precision int
+		typmod    int32
+	}
+
+	// Typmods are the same as in PostgreSQL pg_attribute.atttypmod
+	test := []testElement{
+		{IntervalFieldsAll, 3, 2147418115},
+		{IntervalFieldsAll, 0, 2147418112},
+		{IntervalFieldsYear, IntervalFullPrecision, 327679},
+		{IntervalFieldsDayToSecond, 3, 470286339},
+		{IntervalFieldsHourToMinute, IntervalFullPrecision, 201392127},
+	}
+
+	for _, v := range test {
+		if typmod := EncodeIntervalTypmod(v.fields, v.precision); typmod != v.typmod {
+			t.Errorf("%v,%v: expect %v, got %v", v.fields, v.precision, v.typmod, typmod)
+		}
+		if fields, precision := DecodeIntervalTypmod(v.typmod); fields != v.fields || precision != v.precision {
+			t.Errorf("%v: expect %v %v, got %v %v", v.typmod, v.fields, v.precision, fields, precision)
+		}
+	}
+
+	if fields, precision := DecodeIntervalTypmod(-1); fields != IntervalFieldsAll || precision != IntervalFullPrecision {
+		t.Errorf("-1: expect %v %v, got %v %v", IntervalFieldsAll, IntervalFullPrecision, fields, precision)
+	}
+}
+
+func TestParseIntervalFields(t *testing.T) {
+	type testElement struct {
+		s      string
+		fields IntervalFields
+		ok     bool
+	}
+
+	test := []testElement{
+		{"", IntervalFieldsAll, true},
+		{"year", IntervalFieldsYear, true},
+		{"MONTH", IntervalFieldsMonth, true},
+		{"Day  To\tSecond", IntervalFieldsDayToSecond, true},
+		{" minute to second ", IntervalFieldsMinuteToSecond, true},
+		{"year to day", 0, false},
+		{"days", 0, false},
+	}
+
+	for _, v := range test {
+		if fields, ok := ParseIntervalFields(v.s); fields != v.fields || ok != v.ok {
+			t.Errorf("%v: expect %v %v, got %v %v", v.s, v.fields, v.ok, fields, ok)
+		}
+		if v.ok && v.fields != IntervalFieldsAll {
+			if fields, ok := ParseIntervalFields(v.fields.String()); fields != v.fields || !ok {
+				t.Errorf("%v: expect %v, got %v %v", v.fields.String(), v.fields, fields, ok)
+			}
+		}
+	}
+}
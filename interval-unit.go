@@ -0,0 +1,107 @@
+package pgtypes
+
+import (
+	"errors"
+	"github.com/apaxa-go/helper/strconvh"
+	"strings"
+)
+
+// IntervalUnit is a unit (field) of interval as used in PostgreSQL EXTRACT and date_trunc functions.
+type IntervalUnit uint8
+
+// Possible interval units.
+const (
+	IntervalUnitMicrosecond IntervalUnit = iota
+	IntervalUnitMillisecond
+	IntervalUnitSecond
+	IntervalUnitMinute
+	IntervalUnitHour
+	IntervalUnitDay
+	IntervalUnitMonth
+	IntervalUnitQuarter
+	IntervalUnitYear
+	IntervalUnitDecade
+	IntervalUnitCentury
+	IntervalUnitMillennium
+	IntervalUnitEpoch
+)
+
+// String returns name of unit as it used in PostgreSQL.
+func (u IntervalUnit) String() string {
+	switch u {
+	case IntervalUnitMicrosecond:
+		return "microseconds"
+	case IntervalUnitMillisecond:
+		return "milliseconds"
+	case IntervalUnitSecond:
+		return "second"
+	case IntervalUnitMinute:
+		return "minute"
+	case IntervalUnitHour:
+		return "hour"
+	case IntervalUnitDay:
+		return "day"
+	case IntervalUnitMonth:
+		return "month"
+	case IntervalUnitQuarter:
+		return "quarter"
+	case IntervalUnitYear:
+		return "year"
+	case IntervalUnitDecade:
+		return "decade"
+	case IntervalUnitCentury:
+		return "century"
+	case IntervalUnitMillennium:
+		return "millennium"
+	case IntervalUnitEpoch:
+		return "epoch"
+	default:
+		return "IntervalUnit(" + strconvh.FormatUint8(uint8(u)) + ")"
+	}
+}
+
+// errIntervalUnitNotSupported returns error for unit which is not supported by some operation.
+func errIntervalUnitNotSupported(u IntervalUnit) error {
+	return errors.New("interval unit " + u.String() + " is not supported")
+}
+
+// ParseIntervalUnit returns unit by its name. ok is false if name is unknown.
+// Name is case insensitive, plural forms and abbreviations are accepted in the same way as in PostgreSQL (e.g. "mins", "hrs", "msec", "quarter", "epoch").
+func ParseIntervalUnit(s string) (u IntervalUnit, ok bool) {
+	s = strings.ToLower(s)
+	if len(s) > intervalUnitMaxLen {
+		s = s[:intervalUnitMaxLen]
+	}
+	switch s {
+	case "epoch":
+		return IntervalUnitEpoch, true
+	case "quarter", "qtr":
+		return IntervalUnitQuarter, true
+	}
+	switch intervalUnits[s] {
+	case intervalDtkMicrosecond:
+		return IntervalUnitMicrosecond, true
+	case intervalDtkMillisecond:
+		return IntervalUnitMillisecond, true
+	case intervalDtkSecond:
+		return IntervalUnitSecond, true
+	case intervalDtkMinute:
+		return IntervalUnitMinute, true
+	case intervalDtkHour:
+		return IntervalUnitHour, true
+	case intervalDtkDay:
+		return IntervalUnitDay, true
+	case intervalDtkMonth:
+		return IntervalUnitMonth, true
+	case intervalDtkYear:
+		return IntervalUnitYear, true
+	case intervalDtkDecade:
+		return IntervalUnitDecade, true
+	case intervalDtkCentury:
+		return IntervalUnitCentury, true
+	case intervalDtkMillennium:
+		return IntervalUnitMillennium, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,52 @@
+package pgtypes
+
+import (
+	"testing"
+)
+
+func TestIntervalUnit_String(t *testing.T) {
+	for u := IntervalUnitMicrosecond; u <= IntervalUnitEpoch; u++ {
+		if r, ok := ParseIntervalUnit(u.String()); !ok || r != u {
+			t.Errorf("%v: expect %v, got %v %v", u.String(), u, r, ok)
+		}
+	}
+	if s := IntervalUnit(100).String(); s != "IntervalUnit(100)" {
+		t.Errorf("expect %v, got %v", "IntervalUnit(100)", s)
+	}
+}
+
+func TestParseIntervalUnit(t *testing.T) {
+	type testElement struct {
+		s  string
+		u  IntervalUnit
+		ok bool
+	}
+
+	test := []testElement{
+		{"us", IntervalUnitMicrosecond, true},
+		{"Microseconds", IntervalUnitMicrosecond, true},
+		{"msec", IntervalUnitMillisecond, true},
+		{"milliseconds", IntervalUnitMillisecond, true},
+		{"s", IntervalUnitSecond, true},
+		{"MINS", IntervalUnitMinute, true},
+		{"hr", IntervalUnitHour, true},
+		{"days", IntervalUnitDay, true},
+		{"mon", IntervalUnitMonth, true},
+		{"qtr", IntervalUnitQuarter, true},
+		{"yrs", IntervalUnitYear, true},
+		{"decades", IntervalUnitDecade, true},
+		{"centuries", IntervalUnitCentury, true},
+		{"millennia", IntervalUnitMillennium, true},
+		{"EPOCH", IntervalUnitEpoch, true},
+		{"week", 0, false},
+		{"ago", 0, false},
+		{"", 0, false},
+		{"fortnight", 0, false},
+	}
+
+	for _, v := range test {
+		if u, ok := ParseIntervalUnit(v.s); u != v.u || ok != v.ok {
+			t.Errorf("%v: expect %v %v, got %v %v", v.s, v.u, v.ok, u, ok)
+		}
+	}
+}
@@ -1,13 +1,9 @@
 package pgtypes
 
 import (
-	"errors"
 	"github.com/apaxa-go/helper/mathh"
 	"github.com/apaxa-go/helper/strconvh"
-	"github.com/apaxa-go/helper/stringsh"
 	"github.com/apaxa-go/helper/timeh"
-	"regexp"
-	"strings"
 	"time"
 )
 
@@ -23,10 +19,6 @@ const (
 	IntervalMaxPrecision         = 12
 )
 
-// RE for parse interval in postgres style specification.
-// http://www.postgresql.org/docs/9.4/interactive/datatype-datetime.html#DATATYPE-INTERVAL-OUTPUT
-var re = regexp.MustCompile(`^(?:([+-]?[0-9]+) years?)? ?(?:([+-]?[0-9]+) mons?)? ?(?:([+-]?[0-9]+) days?)? ?(?:([+-])?([0-9]+):([0-9]+):([0-9]+)(?:,|.([0-9]+))?)?$`)
-
 // Interval represent time interval in Postgres-compatible way.
 // It consists of 3 public fields:
 // 	Months - number months
@@ -41,6 +33,7 @@ var re = regexp.MustCompile(`^(?:([+-]?[0-9]+) years?)? ?(?:([+-]?[0-9]+) mons?)
 // This type is similar to Postgres interval data type.
 // Value from one field is never automatically translated to value of another field, so <60*60*24 seconds> != <1 days> and so on.
 // This is because of compatibility with Postgres, moreover day may have different amount of seconds and month may have different amount of days.
+// Use JustifyDays, JustifyHours and JustifyInterval for explicit conversion (as in PostgreSQL).
 type Interval struct {
 	Months      int32
 	Days        int32
@@ -100,120 +93,41 @@ func Year() Interval {
 }
 
 // ParseInterval parses incoming string and extract interval with requested precision p.
-// Format is postgres style specification for interval output format.
+// It accepts the same syntax as PostgreSQL interval input (with IntervalStyle other than sql_standard):
+// output of any IntervalStyle, units with abbreviations (from microseconds to millennia), fractional values, "ago" and ISO 8601 format.
+// Fractional parts cascade into smaller fields in the same way as PostgreSQL does.
+// Fraction of second is rounded half away from zero to precision p (all digits are taken into account), use ParseIntervalWithOptions to detect precision from input or to fail instead of rounding.
+// Empty string is parsed as zero interval.
+// If string can not be parsed then returned error is *ParseError.
 // Examples:
 // 	-1 year 2 mons -3 days 04:05:06.789
 // 	1 mons
-// 	2 year -34:56:78
+// 	2 year -34:56:18
 // 	00:00:00
-//
-// BUG(unsacrificed): ParseInterval may overflow SomeSeconds if computed SomeSeconds should be MinInt64.
-func ParseInterval(s string, p uint8) (i Interval, err error) {
-	if p > IntervalMaxPrecision {
-		i.precision = IntervalMaxPrecision
-	} else {
-		i.precision = p
-	}
-
-	parts := re.FindStringSubmatch(s)
-	if parts == nil || len(parts) != 9 {
-		err = errors.New("unable to parse interval from string " + s)
-		return
-	}
-
-	var ti32 int32
-
-	// Store as months:
-
-	// years
-	if parts[1] != "" {
-		ti32, err = strconvh.ParseInt32(parts[1])
-		if err != nil {
-			return
-		}
-		i.Months = ti32 * timeh.MonthsInYear
-	}
-
-	// months
-	if parts[2] != "" {
-		ti32, err = strconvh.ParseInt32(parts[2])
-		if err != nil {
-			return
-		}
-		i.Months += ti32
-	}
-
-	// Store as days:
-
-	// days
-	if parts[3] != "" {
-		ti32, err = strconvh.ParseInt32(parts[3])
-		if err != nil {
-			return
-		}
-		i.Days = ti32
-	}
-
-	var ti64 int64
-
-	// Store as seconds:
-
-	negativeTime := parts[4] == "-"
-
-	// hours
-	if parts[5] != "" {
-		ti64, err = strconvh.ParseInt64(parts[5])
-		if err != nil {
-			return
-		}
-		i.SomeSeconds = ti64 // Now SomeSeconds contains hours
-	}
-	i.SomeSeconds *= timeh.MinsInHour // Now SomeSeconds contains minutes
-
-	// minutes
-	if parts[6] != "" {
-		ti64, err = strconvh.ParseInt64(parts[6])
-		if err != nil {
-			return
-		}
-		i.SomeSeconds += ti64
-	}
-	i.SomeSeconds *= timeh.SecsInMin // Now SomeSeconds contains seconds
-
-	// seconds
-	if parts[7] != "" {
-		ti64, err = strconvh.ParseInt64(parts[7]) // Possible overflow
-		if err != nil {
-			return
-		}
-		i.SomeSeconds += ti64
-	}
-
-	i.SomeSeconds *= mathh.PowInt64(10, int64(p)) // Now SomeSeconds contains units with required precision
-
-	if parts[8] != "" {
-		if len(parts[8]) < int(p) {
-			parts[8] = stringsh.PadRightWithByte(parts[8], '0', int(p))
-		}
-		ti64, err = strconvh.ParseInt64(parts[8][:p]) // Possible overflow
-		if err != nil {
-			return // It is impossible to cover this case because of RegExp and limits on p (p<Digits(MaxInt64))
-		}
-		i.SomeSeconds += ti64
-
-		if len(parts[8]) > int(p) && parts[8][int(p)] >= '5' { // Round-to-upper if needed
-			i.SomeSeconds++
-		}
+// 	1 hr 30 min
+// 	1.5 days ago
+// 	@ 2 weeks 3 ms
+// 	P1Y2M3DT4H5M6S
+func ParseInterval(s string, p uint8) (Interval, error) {
+	if s == "" {
+		return NewInterval(p), nil
 	}
+	return parseInterval(s, p, false, nil)
+}
 
-	if negativeTime {
-		i.SomeSeconds *= -1
+// parseInterval parses interval in PostgreSQL input syntax.
+// As PostgreSQL does, it tries ISO 8601 format if string is not valid in other formats.
+// Mode configures parsing of fraction of second, it may be nil.
+func parseInterval(s string, p uint8, sqlStandard bool, mode *intervalParseMode) (Interval, error) {
+	i, err := decodeInterval(s, p, sqlStandard, mode)
+	if _, ok := err.(*ParseError); ok && len(s) > 0 && s[0] == 'P' {
+		return parseIntervalISO8601(s, p, mode)
 	}
-
-	return
+	return i, err
 }
 
 // FromDuration returns new Interval equivalent for given time.Duration (convert time.Duration to Interval).
+// Result has nanosecond precision and zero months and days parts, so conversion is exact and ToDuration returns original d.
 func FromDuration(d time.Duration) Interval {
 	return Interval{SomeSeconds: d.Nanoseconds(), precision: IntervalGoPrecision}
 }
@@ -290,8 +204,6 @@ func (i Interval) Precision() uint8 {
 
 // String returns string representation of interval.
 // Output format is the same as for Parse.
-//
-// BUG(unsacrificed): String may overflow SomeSeconds if SomeSeconds is MinInt64.
 func (i Interval) String() string {
 	if i.Months == 0 && i.Days == 0 && i.SomeSeconds == 0 {
 		return "00:00:00"
@@ -312,33 +224,11 @@ func (i Interval) String() string {
 	}
 
 	if i.SomeSeconds != 0 {
-		negativeTime := i.SomeSeconds < 0
-		if negativeTime {
-			i.SomeSeconds *= -1 // Possible overflow
-		}
-
-		tmp := mathh.PowInt64(10, int64(i.precision))
-		h := i.NormalHours()
-		m := i.NormalMinutes()
-		f := i.SomeSeconds % (tmp * timeh.SecsInMin)
-		s := f / tmp
-		f -= s * tmp
-
+		negativeTime, h, m, sec, f := intervalSecondsParts(i.SomeSeconds, i.precision)
 		if negativeTime {
 			str += "-"
 		}
-
-		str += stringsh.PadLeftWithByte(strconvh.FormatInt64(h), '0', 2) + ":" +
-			stringsh.PadLeftWithByte(strconvh.FormatInt64(m), '0', 2) + ":" +
-			stringsh.PadLeftWithByte(strconvh.FormatInt64(s), '0', 2)
-		if f != 0 {
-			str += "." + strings.TrimRight(
-				stringsh.PadLeftWithByte(strconvh.FormatInt64(f), '0', int(i.precision)),
-				"0",
-			)
-		}
-
-		return str
+		return str + formatIntervalTime(h, m, sec, f, i.precision, true)
 	}
 	// As all null interval filtered at the beginning of method there is a space at the end of string
 	return str[:len(str)-1]
@@ -348,8 +238,17 @@ func (i Interval) String() string {
 // It is required to pass number of days in month (usually 30 or something near)
 // and number of minutes in day (usually 1440) because of converting months and days parts of original Interval to time.Duration nanoseconds.
 // Warning: this method is inaccuracy because in real life daysInMonth & minutesInDay vary and depends on relative timestamp.
+// Seconds part is scaled from precision of interval to nanoseconds and sub-nanosecond part is truncated.
+// Note: before ToDuration was added result was correct only for intervals with nanosecond precision (for other precisions parts were not scaled to nanoseconds).
+// Result silently overflows if interval is longer than about 292 years, use ToDuration to detect overflow or Seconds for exact conversion without overflow.
 func (i Interval) Duration(daysInMonth uint8, minutesInDay uint32) time.Duration {
-	return time.Duration((int64(i.Months)*int64(daysInMonth)+int64(i.Days))*int64(minutesInDay)*mathh.PowInt64(10, int64(i.precision))*timeh.SecsInMin + i.SomeSeconds)
+	ns := i.SomeSeconds
+	if i.precision <= IntervalNanosecondPrecision {
+		ns *= mathh.PowInt64(10, int64(IntervalNanosecondPrecision-i.precision))
+	} else {
+		ns /= mathh.PowInt64(10, int64(i.precision-IntervalNanosecondPrecision))
+	}
+	return time.Duration((int64(i.Months)*int64(daysInMonth)+int64(i.Days))*int64(minutesInDay)*timeh.SecsInMin*int64(time.Second) + ns)
 }
 
 // someSecondsChangePrecision recalculates s (with precision from) to precision to and return result.
@@ -362,7 +261,16 @@ func someSecondsChangePrecision(s int64, from, to uint8) int64 {
 	return mathh.DivideRoundFixInt64(s, mathh.PowInt64(10, int64(from-to)))
 }
 
+// someSecondsChangePrecisionChecked is the same as someSecondsChangePrecision but ok is false if result overflows int64.
+func someSecondsChangePrecisionChecked(s int64, from, to uint8) (int64, bool) {
+	if to >= from {
+		return mulInt64(s, mathh.PowInt64(10, int64(to-from)))
+	}
+	return divRoundInt64(s, mathh.PowInt64(10, int64(from-to)))
+}
+
 // Add returns i+add.
+// Parts of result silently overflow, use AddChecked to detect overflow.
 func (i Interval) Add(add Interval) Interval {
 	i.Months += add.Months
 	i.Days += add.Days
@@ -371,6 +279,7 @@ func (i Interval) Add(add Interval) Interval {
 }
 
 // Sub returns i-sub.
+// Parts of result silently overflow, use SubChecked to detect overflow.
 func (i Interval) Sub(sub Interval) Interval {
 	i.Months -= sub.Months
 	i.Days -= sub.Days
@@ -379,6 +288,7 @@ func (i Interval) Sub(sub Interval) Interval {
 }
 
 // Mul returns interval i multiplied by mul. Each part of Interval multiples independently.
+// Parts of result silently overflow (months and days parts are truncated to int32), use MulChecked to detect overflow.
 func (i Interval) Mul(mul int64) Interval {
 	i.Months, i.Days, i.SomeSeconds = int32(int64(i.Months)*mul), int32(int64(i.Days)*mul), i.SomeSeconds*mul
 	return i
@@ -386,6 +296,7 @@ func (i Interval) Mul(mul int64) Interval {
 
 // Div divides interval by mul and returns result. Each part of Interval divides independently.
 // Round rule: 0.4=>0 ; 0.5=>1 ; 0.6=>1 ; -0.4=>0 ; -0.5=>-1 ; -0.6=>-1
+// Div panics if div is zero, use DivChecked to get error instead.
 func (i Interval) Div(div int64) Interval {
 	i.Months = int32(mathh.DivideRoundFixInt64(int64(i.Months), div))
 	i.Days = int32(mathh.DivideRoundFixInt64(int64(i.Days), div))
@@ -393,6 +304,55 @@ func (i Interval) Div(div int64) Interval {
 	return i
 }
 
+// AddChecked returns i+add.
+// Unlike Add it returns error if any part of result overflows (as PostgreSQL does).
+func (i Interval) AddChecked(add Interval) (Interval, error) {
+	ss, ok1 := someSecondsChangePrecisionChecked(add.SomeSeconds, add.precision, i.precision)
+	ss, ok2 := addInt64(i.SomeSeconds, ss)
+	if !ok1 || !ok2 {
+		return Interval{}, errIntervalOutOfRange
+	}
+	return newIntervalChecked(int64(i.Months)+int64(add.Months), int64(i.Days)+int64(add.Days), ss, i.precision)
+}
+
+// SubChecked returns i-sub.
+// Unlike Sub it returns error if any part of result overflows (as PostgreSQL does).
+func (i Interval) SubChecked(sub Interval) (Interval, error) {
+	ss, ok1 := someSecondsChangePrecisionChecked(sub.SomeSeconds, sub.precision, i.precision)
+	ss, ok2 := subInt64(i.SomeSeconds, ss)
+	if !ok1 || !ok2 {
+		return Interval{}, errIntervalOutOfRange
+	}
+	return newIntervalChecked(int64(i.Months)-int64(sub.Months), int64(i.Days)-int64(sub.Days), ss, i.precision)
+}
+
+// MulChecked returns interval i multiplied by mul. Each part of Interval multiples independently.
+// Unlike Mul it returns error if any part of result overflows (as PostgreSQL does).
+func (i Interval) MulChecked(mul int64) (Interval, error) {
+	months, ok1 := mulInt64(int64(i.Months), mul)
+	days, ok2 := mulInt64(int64(i.Days), mul)
+	ss, ok3 := mulInt64(i.SomeSeconds, mul)
+	if !ok1 || !ok2 || !ok3 {
+		return Interval{}, errIntervalOutOfRange
+	}
+	return newIntervalChecked(months, days, ss, i.precision)
+}
+
+// DivChecked divides interval by div and returns result. Each part of Interval divides independently with the same round rule as in Div.
+// Unlike Div it returns error if div is zero or if any part of result overflows (as PostgreSQL does).
+func (i Interval) DivChecked(div int64) (Interval, error) {
+	if div == 0 {
+		return Interval{}, errIntervalDivideByZero
+	}
+	months, _ := divRoundInt64(int64(i.Months), div)
+	days, _ := divRoundInt64(int64(i.Days), div)
+	ss, ok := divRoundInt64(i.SomeSeconds, div)
+	if !ok {
+		return Interval{}, errIntervalOutOfRange
+	}
+	return newIntervalChecked(months, days, ss, i.precision)
+}
+
 // SafePrec returns minimal precision which can be used for current Interval without data loss.
 // Examples:
 // 	10 seconds => 0 (second precision, can not be less)
@@ -430,6 +390,7 @@ func (i Interval) In(i2 Interval) int64 {
 // 	sign<0 => i<i2
 // 	sign=0 => i=i2
 // 	sign>0 => i>i2
+// Use CmpTotal for comparison in the same way as PostgreSQL does (it is always possible).
 func (i Interval) Cmp(i2 Interval) (sign int, ok bool) {
 	var mSign, dSign, sSign int
 
@@ -564,6 +525,7 @@ func (i Interval) NormalNanoseconds() int64 {
 }
 
 // AddTo adds original Interval to given timestamp and return result.
+// It uses time.Time.AddDate, so "2021-01-31" + "1 mon" is "2021-03-03". Use AddToIn to get the same result as in PostgreSQL.
 func (i Interval) AddTo(t time.Time) time.Time {
 	return t.AddDate(0, int(i.Months), int(i.Days)).Add(time.Duration(someSecondsChangePrecision(i.SomeSeconds, i.precision, IntervalNanosecondPrecision)))
 }
@@ -42,14 +42,14 @@ func TestParse(t *testing.T) {
 		{"-1 year -2 mons +3 days -04:05:06", IntervalMicrosecondPrecision, Interval{-14, 3, -14706 * 1e9, IntervalNanosecondPrecision}, false},
 		{"-1 year 2 mons -3 days 04:05:06.789", IntervalMicrosecondPrecision, Interval{-10, -3, 14706789 * 1e6, IntervalNanosecondPrecision}, false},
 		{"", IntervalMicrosecondPrecision, Interval{0, 0, 0, IntervalNanosecondPrecision}, false},
-		{"00:00", IntervalMicrosecondPrecision, Interval{}, true},
+		{"00:00", IntervalMicrosecondPrecision, Interval{0, 0, 0, IntervalNanosecondPrecision}, false},
 		{"year mons days", IntervalMicrosecondPrecision, Interval{}, true},
-		{"1.5 year", IntervalMicrosecondPrecision, Interval{}, true},
+		{"1.5 year", IntervalMicrosecondPrecision, Interval{18, 0, 0, IntervalNanosecondPrecision}, false},
 		{"1,5 year", IntervalMicrosecondPrecision, Interval{}, true},
 		{"99999999999 year -2 mons +3 days -04:05:06", IntervalMicrosecondPrecision, Interval{}, true},
 		{"9 year 9999999999 mons +3 days -04:05:06", IntervalMicrosecondPrecision, Interval{}, true},
 		{"9 year -2 mons +99999999999 days -04:05:06", IntervalMicrosecondPrecision, Interval{}, true},
-		{"9 year -2 mons +9 days 040506", IntervalMicrosecondPrecision, Interval{}, true},
+		{"9 year -2 mons +9 days 040506", IntervalMicrosecondPrecision, Interval{106, 9, 40506 * 1e9, IntervalNanosecondPrecision}, false},
 		{"9 year -2 mons +9 days 04:06:99999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999", IntervalMicrosecondPrecision, Interval{}, true},
 		//TODO waiting check overflow
 		//{"2147483647 year 2147483647 mons 2147483647 days 00:00:00", MicrosecondPrecision, Interval{2147483647, 2147483647, 0, MicrosecondPrecision}, false},
@@ -63,6 +63,14 @@ func TestParse(t *testing.T) {
 		{"00:00:00.132428754353245897987092345345", IntervalMillisecondPrecision, Interval{0, 0, 132, IntervalMillisecondPrecision}, false},
 		{"00:00:00.132528754353245897987092345345", IntervalMillisecondPrecision, Interval{0, 0, 133, IntervalMillisecondPrecision}, false},
 		{"00:00:00.132628754353245897987092345345", IntervalMillisecondPrecision, Interval{0, 0, 133, IntervalMillisecondPrecision}, false},
+		{"-2562047:47:16.854775808", IntervalNanosecondPrecision, Interval{0, 0, mathh.MinInt64, IntervalNanosecondPrecision}, false},
+		{"2562047:47:16.854775807", IntervalNanosecondPrecision, Interval{0, 0, mathh.MaxInt64, IntervalNanosecondPrecision}, false},
+		{"-2562047:47:16.854775809", IntervalNanosecondPrecision, Interval{}, true},
+		{"-9223372036854775808 microseconds", IntervalMicrosecondPrecision, Interval{0, 0, mathh.MinInt64, IntervalMicrosecondPrecision}, false},
+		{"9223372036854775807 microseconds", IntervalMicrosecondPrecision, Interval{0, 0, mathh.MaxInt64, IntervalMicrosecondPrecision}, false},
+		{"9223372036854775808 microseconds", IntervalMicrosecondPrecision, Interval{}, true},
+		{"-9223372036854775809 microseconds", IntervalMicrosecondPrecision, Interval{}, true},
+		{"-178956970 years -8 mons -2147483648 days", IntervalNanosecondPrecision, Interval{mathh.MinInt32, mathh.MinInt32, 0, IntervalNanosecondPrecision}, false},
 	}
 
 	for _, v := range test {
@@ -98,6 +106,8 @@ func TestString(t *testing.T) {
 		//-2147483648 to 2147483647
 		{"178956970 year 7 mons 2147483647 days", Interval{2147483647, 2147483647, 0, IntervalNanosecondPrecision}, false},
 		{"-178956970 year -8 mons -2147483648 days", Interval{-2147483648, -2147483648, 0, IntervalNanosecondPrecision}, false},
+		{"-2562047:47:16.854775808", Interval{0, 0, mathh.MinInt64, IntervalNanosecondPrecision}, false},
+		{"2562047:47:16.854775807", Interval{0, 0, mathh.MaxInt64, IntervalNanosecondPrecision}, false},
 	}
 
 	for _, v := range test {
@@ -148,6 +158,11 @@ func TestDuration(t *testing.T) {
 		{Interval{20, 10, 1 * 1e9, IntervalNanosecondPrecision}, 0, 0, time.Second},
 		{Interval{-10, -5, -1 * 1e9, IntervalNanosecondPrecision}, 30, 1400, -25620001 * time.Second},
 		{Interval{0, 0, 0, IntervalNanosecondPrecision}, 30, 1400, 0},
+		{Interval{0, 0, 1500000, IntervalMicrosecondPrecision}, 30, 1440, 1500 * time.Millisecond},
+		{Interval{1, 1, -1500000, IntervalMicrosecondPrecision}, 30, 1440, 31*24*time.Hour - 1500*time.Millisecond},
+		{Interval{0, 0, 1500000000001, IntervalPicosecondPrecision}, 30, 1440, 1500 * time.Millisecond},
+		{Interval{0, 1, -1500000000999, IntervalPicosecondPrecision}, 30, 1440, 24*time.Hour - 1500*time.Millisecond},
+		{Interval{0, 1, 5, IntervalSecondPrecision}, 30, 1440, 86405 * time.Second},
 	}
 
 	for _, v := range test {
@@ -225,6 +240,117 @@ func TestDiv(t *testing.T) {
 	}
 }
 
+func TestInterval_AddChecked(t *testing.T) {
+	type testElement struct {
+		i   Interval
+		add Interval
+		res Interval
+		err bool
+	}
+
+	const p = IntervalMicrosecondPrecision
+	test := []testElement{
+		{Interval{1, 2, 3, p}, Interval{4, 5, 6, p}, Interval{5, 7, 9, p}, false},
+		{Interval{1, 2, 3000, p}, Interval{4, 5, 6, IntervalMillisecondPrecision}, Interval{5, 7, 9000, p}, false},
+		{Interval{1, 2, 3, IntervalMillisecondPrecision}, Interval{4, 5, 1500, p}, Interval{5, 7, 5, IntervalMillisecondPrecision}, false},
+		{Interval{mathh.MaxInt32, 0, 0, p}, Interval{-1, 0, 0, p}, Interval{mathh.MaxInt32 - 1, 0, 0, p}, false},
+		{Interval{0, 0, mathh.MinInt64 + 1, p}, Interval{0, 0, -1, p}, Interval{0, 0, mathh.MinInt64, p}, false},
+		{Interval{mathh.MaxInt32, 0, 0, p}, Interval{1, 0, 0, p}, Interval{}, true},
+		{Interval{0, mathh.MinInt32, 0, p}, Interval{0, -1, 0, p}, Interval{}, true},
+		{Interval{0, 0, mathh.MaxInt64, p}, Interval{0, 0, 1, p}, Interval{}, true},
+		{Interval{0, 0, 0, IntervalPicosecondPrecision}, Interval{0, 0, mathh.MaxInt64, IntervalSecondPrecision}, Interval{}, true},
+	}
+
+	for _, v := range test {
+		i, err := v.i.AddChecked(v.add)
+		if (err != nil) != v.err || !v.err && i != v.res {
+			t.Errorf("%#v+%#v: expect %#v %v, got %#v %v", v.i, v.add, v.res, v.err, i, err)
+		}
+	}
+}
+
+func TestInterval_SubChecked(t *testing.T) {
+	type testElement struct {
+		i   Interval
+		sub Interval
+		res Interval
+		err bool
+	}
+
+	const p = IntervalMicrosecondPrecision
+	test := []testElement{
+		{Interval{1, 2, 3, p}, Interval{4, 5, 6, p}, Interval{-3, -3, -3, p}, false},
+		{Interval{1, 2, 3000, p}, Interval{4, 5, 6, IntervalMillisecondPrecision}, Interval{-3, -3, -3000, p}, false},
+		{Interval{0, 0, -1, p}, Interval{0, 0, mathh.MaxInt64, p}, Interval{0, 0, mathh.MinInt64, p}, false},
+		{Interval{mathh.MinInt32, 0, 0, p}, Interval{1, 0, 0, p}, Interval{}, true},
+		{Interval{0, mathh.MaxInt32, 0, p}, Interval{0, -1, 0, p}, Interval{}, true},
+		{Interval{0, 0, 0, p}, Interval{0, 0, mathh.MinInt64, p}, Interval{}, true},
+	}
+
+	for _, v := range test {
+		i, err := v.i.SubChecked(v.sub)
+		if (err != nil) != v.err || !v.err && i != v.res {
+			t.Errorf("%#v-%#v: expect %#v %v, got %#v %v", v.i, v.sub, v.res, v.err, i, err)
+		}
+	}
+}
+
+func TestInterval_MulChecked(t *testing.T) {
+	type testElement struct {
+		i   Interval
+		mul int64
+		res Interval
+		err bool
+	}
+
+	const p = IntervalMicrosecondPrecision
+	test := []testElement{
+		{Interval{1, 2, 3, p}, 2, Interval{2, 4, 6, p}, false},
+		{Interval{1, 2, 3, p}, -2, Interval{-2, -4, -6, p}, false},
+		{Interval{1, 2, 3, p}, 0, Interval{0, 0, 0, p}, false},
+		{Interval{-1, 0, 0, p}, -mathh.MinInt32, Interval{mathh.MinInt32, 0, 0, p}, false},
+		{Interval{1, 0, 0, p}, mathh.MaxInt32 + 1, Interval{}, true},
+		{Interval{0, 2, 0, p}, 1 << 31, Interval{}, true},
+		{Interval{0, 0, mathh.MinInt64, p}, -1, Interval{}, true},
+		{Interval{0, 0, 1 << 32, p}, 1 << 32, Interval{}, true},
+	}
+
+	for _, v := range test {
+		i, err := v.i.MulChecked(v.mul)
+		if (err != nil) != v.err || !v.err && i != v.res {
+			t.Errorf("%#v*%v: expect %#v %v, got %#v %v", v.i, v.mul, v.res, v.err, i, err)
+		}
+	}
+}
+
+func TestInterval_DivChecked(t *testing.T) {
+	type testElement struct {
+		i   Interval
+		div int64
+		res Interval
+		err bool
+	}
+
+	const p = IntervalMicrosecondPrecision
+	test := []testElement{
+		{Interval{4, 6, 8, p}, 2, Interval{2, 3, 4, p}, false},
+		{Interval{5, -5, 7, p}, 2, Interval{3, -3, 4, p}, false},
+		{Interval{4, 6, 8, p}, -3, Interval{-1, -2, -3, p}, false},
+		{Interval{0, 0, mathh.MinInt64, p}, 2, Interval{0, 0, mathh.MinInt64 / 2, p}, false},
+		{Interval{0, 0, mathh.MaxInt64, p}, mathh.MinInt64, Interval{0, 0, -1, p}, false},
+		{Interval{1, 2, 3, p}, 0, Interval{}, true},
+		{Interval{mathh.MinInt32, 0, 0, p}, -1, Interval{}, true},
+		{Interval{0, 0, mathh.MinInt64, p}, -1, Interval{}, true},
+	}
+
+	for _, v := range test {
+		i, err := v.i.DivChecked(v.div)
+		if (err != nil) != v.err || !v.err && i != v.res {
+			t.Errorf("%#v/%v: expect %#v %v, got %#v %v", v.i, v.div, v.res, v.err, i, err)
+		}
+	}
+}
+
 func TestInterval_Cmp(t *testing.T) {
 	type testElement struct {
 		i          Interval
@@ -390,6 +390,9 @@ func (z *Numeric) QuoPrec(x, y *Numeric, scale int16, round bool) *Numeric {
 	}
 
 	z.digits, z.weight = divAbs(x.digits, x.weight, y.digits, y.weight, scale, round)
+	if len(z.digits) == 0 { // Result may be rounded or truncated to zero
+		z.sign = numericPositive
+	}
 
 	return z
 }
@@ -1,11 +1,70 @@
 package pgtypes
 
 import (
-	"github.com/apaxa-go/helper/strconvh"
+	"math/big"
 	"reflect"
 	"testing"
+	"testing/quick"
 )
 
+// ratRound rounds x to scale decimal digits after the decimal point.
+// If round is true it rounds half away from zero, otherwise it truncates.
+func ratRound(x *big.Rat, scale int16, round bool) *big.Rat {
+	pow := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)
+	num := new(big.Int).Mul(x.Num(), pow)
+	q, r := num.QuoRem(num, x.Denom(), new(big.Int))
+	if round && new(big.Int).Abs(r).Lsh(r.Abs(r), 1).Cmp(x.Denom()) >= 0 {
+		q.Add(q, big.NewInt(int64(x.Sign())))
+	}
+	return new(big.Rat).SetFrac(q, pow)
+}
+
+// numericQuoProperty returns property which checks that QuoPrec result (with scale returned by scale function) is equal to exactly computed quotient rounded or truncated to the same scale.
+func numericQuoProperty(scale func(x, y *Numeric) int16, round bool) func(x, y *Numeric) bool {
+	return func(x, y *Numeric) bool {
+		if y.IsZero() {
+			return true
+		}
+		var z Numeric
+		s := scale(x, y)
+		z.QuoPrec(x, y, s, round)
+		if x.IsNaN() || y.IsNaN() {
+			return z.IsNaN()
+		}
+		q := new(big.Rat).Quo(numericRat(x), numericRat(y))
+		return numericValid(&z) && numericRat(&z).Cmp(ratRound(q, s, round)) == 0
+	}
+}
+
+// numericQuoRemProperty checks QuoRem and Rem results against exactly computed truncated quotient and remainder.
+func numericQuoRemProperty(x, y *Numeric) bool {
+	if y.IsZero() {
+		return true
+	}
+	var q, r, r2 Numeric
+	q.QuoRem(x, y, &r)
+	r2.Rem(x, y)
+	if x.IsNaN() || y.IsNaN() {
+		return q.IsNaN() && r.IsNaN() && r2.IsNaN()
+	}
+	xr, yr := numericRat(x), numericRat(y)
+	qr := ratRound(new(big.Rat).Quo(xr, yr), 0, false)
+	rr := new(big.Rat).Sub(xr, new(big.Rat).Mul(qr, yr))
+	return numericValid(&q) && numericValid(&r) && numericRat(&q).Cmp(qr) == 0 && numericRat(&r).Cmp(rr) == 0 && r2.Cmp(&r) == 0
+}
+
+// numericQuoDefaultProperty checks that Quo is the same as QuoPrec with default scale and rounding and checks its result against exactly computed quotient.
+func numericQuoDefaultProperty(x, y *Numeric) bool {
+	if y.IsZero() {
+		return true
+	}
+	scale := func(x, y *Numeric) int16 { return selectDivScaleAbs(x.digits, x.weight, y.digits, y.weight) }
+	var z1, z2 Numeric
+	z1.Quo(x, y)
+	z2.QuoPrec(x, y, scale(x, y), true)
+	return reflect.DeepEqual(z1, z2) && numericQuoProperty(scale, true)(x, y)
+}
+
 func TestNumeric_QuoRem(t *testing.T) {
 	type testElement struct {
 		a, b     string
@@ -47,6 +106,8 @@ func TestNumeric_QuoRem(t *testing.T) {
 			t.Errorf("%v,%v: expect %v %v %v, got %v %v %v", &a, &b, &quo, &rem, &rem, &r1, &r2, &r3)
 		}
 	}
+
+	checkNumericBinary(t, numericQuoRemProperty)
 }
 
 func TestNumeric_QuoRem2(t *testing.T) {
@@ -64,38 +125,7 @@ func TestNumeric_QuoRem2(t *testing.T) {
 }
 
 func TestNumeric_Quo(t *testing.T) {
-	delta := float64(1e-7)
-	var deltaN Numeric
-	deltaN.setString(strconvh.FormatFloat64(delta))
-
-	for _, v1 := range numericTests {
-		for _, v2 := range numericTests {
-			if v2 == 0 {
-				continue
-			}
-			var a, b, c Numeric
-			if _, ok := a.SetString(strconvh.FormatInt64(v1)); !ok {
-				t.Errorf("unable to parse int64 %v", v1)
-			}
-			if _, ok := b.SetString(strconvh.FormatInt64(v2)); !ok {
-				t.Errorf("unable to parse int64 %v", v2)
-			}
-			c.Quo(&a, &b)
-
-			r := float64(v1) / float64(v2)
-			if r == 0 { // Avoid float -0
-				r = 0
-			}
-
-			var rN, rD Numeric
-			rN.setString(strconvh.FormatFloat64(r))
-			rD.Sub(&c, &rN).Abs(&rD)
-
-			if s1, s2 := c.String(), rN.String(); rD.Cmp(&deltaN) > 0 {
-				t.Errorf("%v,%v: expected %v, got %v", v1, v2, s2, s1)
-			}
-		}
-	}
+	checkNumericBinary(t, numericQuoDefaultProperty)
 }
 
 func TestNumeric_Quo2(t *testing.T) {
@@ -171,6 +201,13 @@ func TestNumeric_QuoPrec(t *testing.T) {
 			t.Errorf("%v,%v,%v,%v: expect %#v, got %#v", &a, &b, v.p, v.round, quo, r)
 		}
 	}
+
+	f := func(x, y *Numeric, scale uint8, round bool) bool {
+		return numericQuoProperty(func(*Numeric, *Numeric) int16 { return int16(scale) }, round)(x, y)
+	}
+	if err := quick.Check(f, quickConfig(1000)); err != nil {
+		t.Error(err)
+	}
 }
 
 // Just for coverage. No other way with current implementation to cover this.
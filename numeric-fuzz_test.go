@@ -0,0 +1,125 @@
+//go:build go1.18
+// +build go1.18
+
+package pgtypes
+
+import (
+	"math/big"
+	"testing"
+)
+
+// numericFuzzSeeds are edge cases for Numeric parsing.
+// Most of them are values from PostgreSQL numeric regression test, others are base (10000) digit boundaries and int64 limits.
+// Additional seeds are stored in testdata/fuzz.
+var numericFuzzSeeds = []string{
+	"0",
+	"-0",
+	"+0.0000",
+	"NaN",
+	".",
+	"-34338492.215397047",
+	"4.31",
+	"7799461.4119",
+	"16397.038491",
+	"93901.57763026",
+	"-83028485",
+	"74881",
+	"-24926804.045047420",
+	"9999",
+	"10000",
+	"0.0001",
+	"0.00009999",
+	"99999999999999999999.99999999999999999999",
+	"-9223372036854775808",
+	"9223372036854775807",
+	"18446744073709551615",
+}
+
+// numericFuzzQuoSeeds are pairs of operands for division.
+// Pairs at the end trigger rare "add back" step of Knuth's algorithm D.
+var numericFuzzQuoSeeds = [][2]string{
+	{"10", "3"},
+	{"-34338492.215397047", "4.31"},
+	{"7799461.4119", "-83028485"},
+	{"93901.57763026", "-24926804.045047420"},
+	{"123002460012300", "10000200001"},
+	{"0.00000000000000000000000000000000000000002", "0.00000000000000000000000000000000000000001"},
+	{"-0.0000006", "-517.394561"},
+	{"-6173776895396095.959", "40351.596383"},
+	{"0.02", "1672859.79"},
+	{"-0.007405", "54730229399.59862"},
+	{"-69166", "-20275875.98642"},
+	{"0.0009", "-15776337799198"},
+}
+
+func FuzzNumeric_SetString(f *testing.F) {
+	for _, v := range numericFuzzSeeds {
+		f.Add(v)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		var n Numeric
+		if _, ok := n.SetString(s); !ok {
+			return
+		}
+		if !numericValid(&n) {
+			t.Fatalf("%q: invalid internal representation %#v", s, n)
+		}
+
+		str := n.String()
+		var n2 Numeric
+		if _, ok := n2.SetString(str); !ok || n2.Cmp(&n) != 0 || !numericValid(&n2) || n2.String() != str {
+			t.Fatalf("%q: round trip via %q failed: %#v", s, str, n2)
+		}
+
+		if n.IsNaN() {
+			return
+		}
+		if r, ok := new(big.Rat).SetString(s); ok && r.Cmp(numericRat(&n)) != 0 {
+			t.Fatalf("%q: expect %v, got %v", s, r.FloatString(20), str)
+		}
+		if r, ok := new(big.Rat).SetString(str); !ok || r.Cmp(numericRat(&n)) != 0 {
+			t.Fatalf("%q: String returns %q which is not equal to %v", s, str, numericRat(&n).FloatString(20))
+		}
+	})
+}
+
+func FuzzNumeric_Quo(f *testing.F) {
+	for _, v := range numericFuzzQuoSeeds {
+		f.Add(v[0], v[1])
+	}
+	f.Fuzz(func(t *testing.T, a, b string) {
+		var x, y Numeric
+		if _, ok := x.SetString(a); !ok {
+			return
+		}
+		if _, ok := y.SetString(b); !ok {
+			return
+		}
+		if !numericQuoDefaultProperty(&x, &y) {
+			t.Errorf("%v/%v: Quo does not match exact quotient", &x, &y)
+		}
+		if !numericQuoRemProperty(&x, &y) {
+			t.Errorf("%v/%v: QuoRem does not match exact quotient and remainder", &x, &y)
+		}
+	})
+}
+
+func FuzzNumeric_QuoPrec(f *testing.F) {
+	for _, v := range numericFuzzQuoSeeds {
+		f.Add(v[0], v[1], uint16(0), true)
+		f.Add(v[0], v[1], uint16(20), false)
+	}
+	f.Fuzz(func(t *testing.T, a, b string, scale uint16, round bool) {
+		var x, y Numeric
+		if _, ok := x.SetString(a); !ok {
+			return
+		}
+		if _, ok := y.SetString(b); !ok {
+			return
+		}
+		s := int16(scale % (pgNumericMaxDisplayScale + 1))
+		if !numericQuoProperty(func(*Numeric, *Numeric) int16 { return s }, round)(&x, &y) {
+			t.Errorf("%v/%v (scale %v, round %v): QuoPrec does not match exact quotient", &x, &y, s, round)
+		}
+	})
+}
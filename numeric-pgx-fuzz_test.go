@@ -0,0 +1,41 @@
+//go:build go1.18
+// +build go1.18
+
+package pgtypes
+
+import (
+	"reflect"
+	"testing"
+)
+
+// pgNumericMaxDScale is a maximum display scale which PostgreSQL accepts in binary representation of numeric.
+// It is a copy of PostgreSQL NUMERIC_DSCALE_MAX defined at "src/backend/utils/adt/numeric.c".
+const pgNumericMaxDScale = 0x3FFF
+
+// FuzzNumeric_EncodePgx checks that Numeric survives round trip to PostgreSQL and back in binary format
+// and that PostgreSQL text representation of it is the same value.
+func FuzzNumeric_EncodePgx(f *testing.F) {
+	for _, v := range numericFuzzSeeds {
+		f.Add(v)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		var n Numeric
+		if _, ok := n.SetString(s); !ok || getScaleAbs(n.digits, n.weight) > pgNumericMaxDScale {
+			return
+		}
+
+		var r Numeric
+		var str string
+		if err := pgxConn.QueryRow("SELECT $1::Numeric, $1::Numeric::Text", n).Scan(&r, &str); err != nil {
+			t.Fatalf("%v: unexpected error %v", &n, err)
+		}
+		if !reflect.DeepEqual(r, n) {
+			t.Errorf("%v: expect %#v, got %#v", &n, n, r)
+		}
+
+		var r2 Numeric
+		if _, ok := r2.SetString(str); !ok || r2.Cmp(&n) != 0 {
+			t.Errorf("%v: PostgreSQL returns %v", &n, str)
+		}
+	})
+}
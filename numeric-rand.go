@@ -0,0 +1,119 @@
+package pgtypes
+
+import (
+	"github.com/apaxa-go/helper/mathh"
+	"math/rand"
+	"reflect"
+)
+
+// Kinds of values produced by Numeric.Rand.
+const (
+	numericRandUniform = iota
+	numericRandZero
+	numericRandFull
+	numericRandSmallest
+	numericRandInt64Limit
+	numericRandKinds
+)
+
+const (
+	// numericGenerateNaNFreq is a probability of NaN in values produced by Numeric.Generate.
+	numericGenerateNaNFreq = 0.05
+	// numericInt64Digits is a number of decimal digits in MaxInt64 and MinInt64.
+	numericInt64Digits = 19
+)
+
+// randDigits returns n pseudo-random decimal digits.
+// If nonZeroFirst (nonZeroLast) is true when first (last) digit is not 0.
+func randDigits(rnd *rand.Rand, n int, nonZeroFirst, nonZeroLast bool) []byte {
+	r := make([]byte, n)
+	for i := range r {
+		r[i] = '0' + byte(rnd.Intn(10))
+	}
+	if n > 0 && nonZeroFirst {
+		r[0] = '1' + byte(rnd.Intn(9))
+	}
+	if n > 0 && nonZeroLast {
+		r[n-1] = '1' + byte(rnd.Intn(9))
+	}
+	return r
+}
+
+// Rand sets z to a pseudo-random value from rnd and returns z.
+// Generated value fits PostgreSQL numeric(precision, scale) type:
+// it has at most precision-scale digits before the decimal point and at most scale digits after the decimal point.
+// If scale is greater than precision then value has at least scale-precision leading zeros after the decimal point (as in PostgreSQL 15).
+// precision is clamped to [1; 1000] and scale is clamped to [0; 1000] (limits of PostgreSQL numeric type modifier).
+// nanFreq is a probability of NaN result, it should be from [0; 1].
+//
+// Besides uniformly distributed values Rand produces edge cases with increased frequency:
+// zero, values with all allowed digits set, values with the smallest allowed weight and values near int64 limits.
+func (z *Numeric) Rand(rnd *rand.Rand, precision, scale int, nanFreq float64) *Numeric {
+	precision = mathh.Min2Int(mathh.Max2Int(precision, 1), pgNumericMaxPrecision)
+	scale = mathh.Min2Int(mathh.Max2Int(scale, 0), pgNumericMaxDisplayScale)
+
+	if rnd.Float64() < nanFreq {
+		return z.SetNaN()
+	}
+
+	intLen := mathh.Max2Int(precision-scale, 0)  // Maximum number of digits before the decimal point
+	zeroLen := mathh.Max2Int(scale-precision, 0) // Minimum number of leading zeros after the decimal point
+	fracLen := scale - zeroLen                   // Maximum number of significant digits after the decimal point
+	negative := rnd.Intn(2) == 0
+
+	var intPart, fracPart []byte
+	switch rnd.Intn(numericRandKinds) {
+	case numericRandZero:
+		return z.SetZero()
+	case numericRandFull:
+		intPart = randDigits(rnd, intLen, true, false)
+		fracPart = randDigits(rnd, fracLen, false, true)
+	case numericRandSmallest:
+		if fracLen > 0 {
+			fracPart = randDigits(rnd, fracLen, false, true)
+			for i := 0; i < fracLen-1; i++ {
+				fracPart[i] = '0'
+			}
+		} else {
+			intPart = randDigits(rnd, 1, false, true)
+		}
+	case numericRandInt64Limit:
+		if intLen >= numericInt64Digits {
+			if negative {
+				return z.SetInt64(mathh.MinInt64 + rnd.Int63n(numericBase))
+			}
+			return z.SetInt64(mathh.MaxInt64 - rnd.Int63n(numericBase))
+		}
+		fallthrough
+	default:
+		intPart = randDigits(rnd, rnd.Intn(intLen+1), false, false)
+		fracPart = randDigits(rnd, rnd.Intn(fracLen+1), false, false)
+	}
+
+	s := make([]byte, 0, 1+len(intPart)+1+zeroLen+len(fracPart))
+	if negative {
+		s = append(s, '-')
+	}
+	s = append(s, intPart...)
+	s = append(s, numericDelimiter)
+	for i := 0; i < zeroLen; i++ {
+		s = append(s, '0')
+	}
+	s = append(s, fracPart...)
+
+	z.setString(string(s))
+	return z
+}
+
+// Generate implements the quick.Generator interface.
+// It returns pseudo-random *Numeric with precision limited by size (see Rand for details).
+// Generated value is NaN with probability 0.05.
+func (*Numeric) Generate(rnd *rand.Rand, size int) reflect.Value {
+	maxPrecision := mathh.Max2Int(size, 1) * numericGroupLen
+	precision := 1 + rnd.Intn(maxPrecision)
+	scale := rnd.Intn(precision + 1)
+	if rnd.Intn(4) == 0 { // Sometimes produce values with large negative weight
+		scale += rnd.Intn(maxPrecision + 1)
+	}
+	return reflect.ValueOf(NewNumeric().Rand(rnd, precision, scale, numericGenerateNaNFreq))
+}
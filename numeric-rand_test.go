@@ -0,0 +1,98 @@
+package pgtypes
+
+import (
+	"github.com/apaxa-go/helper/mathh"
+	"math/rand"
+	"strings"
+	"testing"
+	"testing/quick"
+)
+
+func TestNumeric_Rand(t *testing.T) {
+	type testElement struct {
+		precision, scale int
+	}
+	tests := []testElement{
+		{1, 0},
+		{1, 1},
+		{4, 2},
+		{10, 0},
+		{19, 0},
+		{20, 1},
+		{38, 10},
+		{5, 20},
+		{1000, 1000},
+		{0, -1},
+		{2000, 2000},
+	}
+	rnd := rand.New(rand.NewSource(1))
+	for _, v := range tests {
+		precision := v.precision
+		switch {
+		case precision < 1:
+			precision = 1
+		case precision > pgNumericMaxPrecision:
+			precision = pgNumericMaxPrecision
+		}
+		scale := v.scale
+		switch {
+		case scale < 0:
+			scale = 0
+		case scale > pgNumericMaxDisplayScale:
+			scale = pgNumericMaxDisplayScale
+		}
+
+		var zero, nonZero bool
+		for i := 0; i < 1000; i++ {
+			var n Numeric
+			n.Rand(rnd, v.precision, v.scale, 0)
+			if !numericValid(&n) || n.IsNaN() {
+				t.Fatalf("%v,%v: invalid value %#v", v.precision, v.scale, n)
+			}
+			zero = zero || n.IsZero()
+			nonZero = nonZero || !n.IsZero()
+
+			s := strings.TrimPrefix(n.String(), "-")
+			intPart, fracPart := s, ""
+			if i := strings.IndexByte(s, numericDelimiter); i >= 0 {
+				intPart, fracPart = s[:i], s[i+1:]
+			}
+			if intPart == "0" {
+				intPart = ""
+			}
+			if len(intPart) > mathh.Max2Int(precision-scale, 0) || len(fracPart) > scale || (fracPart != "" && len(fracPart)-len(strings.TrimLeft(fracPart, "0")) < scale-precision) {
+				t.Fatalf("%v,%v: value %v does not fit", v.precision, v.scale, &n)
+			}
+		}
+		if !zero || !nonZero {
+			t.Errorf("%v,%v: expect both zero and non zero values", v.precision, v.scale)
+		}
+	}
+}
+
+func TestNumeric_Rand2(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		if !NewNumeric().Rand(rnd, 10, 2, 1).IsNaN() {
+			t.Fatal("expect NaN")
+		}
+		if NewNumeric().Rand(rnd, 10, 2, 0).IsNaN() {
+			t.Fatal("expect not NaN")
+		}
+	}
+}
+
+func TestNumeric_Generate(t *testing.T) {
+	var nan, int64Limit bool
+	f := func(x *Numeric) bool {
+		nan = nan || x.IsNaN()
+		int64Limit = int64Limit || (x.weight == 4 && len(x.digits) == 5 && x.digits[0] == 922)
+		return numericValid(x)
+	}
+	if err := quick.Check(f, quickConfig(10000)); err != nil {
+		t.Error(err)
+	}
+	if !nan || !int64Limit {
+		t.Errorf("expect NaN and values near int64 limits, got %v %v", nan, int64Limit)
+	}
+}
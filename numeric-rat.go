@@ -0,0 +1,29 @@
+package pgtypes
+
+import (
+	"github.com/apaxa-go/helper/stringsh"
+	"math/big"
+	"strings"
+)
+
+// rat returns value of x as big.Rat. ok is false if x is NaN.
+func (x *Numeric) rat() (r *big.Rat, ok bool) {
+	if x.IsNaN() {
+		return nil, false
+	}
+	return new(big.Rat).SetString(x.String())
+}
+
+// setScaled sets z to v*10^(-scale) and returns z.
+func (z *Numeric) setScaled(v *big.Int, scale int) *Numeric {
+	s := new(big.Int).Abs(v).String()
+	if scale > 0 {
+		s = stringsh.PadLeftWithByte(s, '0', scale+1)
+		s = strings.TrimRight(s[:len(s)-scale]+"."+strings.TrimRight(s[len(s)-scale:], "0"), ".")
+	}
+	if v.Sign() < 0 {
+		s = "-" + s
+	}
+	z.SetString(s)
+	return z
+}
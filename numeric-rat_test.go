@@ -0,0 +1,57 @@
+package pgtypes
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestNumeric_rat(t *testing.T) {
+	type testElement struct {
+		s  string
+		r  *big.Rat
+		ok bool
+	}
+
+	test := []testElement{
+		{"0", big.NewRat(0, 1), true},
+		{"123.45", big.NewRat(12345, 100), true},
+		{"-0.0001", big.NewRat(-1, 10000), true},
+		{"100000000", big.NewRat(100000000, 1), true},
+		{"NaN", nil, false},
+	}
+
+	for _, v := range test {
+		x, ok := NewNumeric().SetString(v.s)
+		if !ok {
+			t.Fatalf("%v: unable to parse", v.s)
+		}
+		r, ok := x.rat()
+		if ok != v.ok || ok && r.Cmp(v.r) != 0 {
+			t.Errorf("%v: expect %v %v, got %v %v", v.s, v.r, v.ok, r, ok)
+		}
+	}
+}
+
+func TestNumeric_setScaled(t *testing.T) {
+	type testElement struct {
+		v     int64
+		scale int
+		s     string
+	}
+
+	test := []testElement{
+		{0, 0, "0"},
+		{0, 6, "0"},
+		{123, 0, "123"},
+		{123, 2, "1.23"},
+		{-123, 5, "-0.00123"},
+		{1500000, 6, "1.5"},
+		{-2000, 3, "-2"},
+	}
+
+	for _, v := range test {
+		if s := NewNumeric().setScaled(big.NewInt(v.v), v.scale).String(); s != v.s {
+			t.Errorf("%v,%v: expect %v, got %v", v.v, v.scale, v.s, s)
+		}
+	}
+}
@@ -113,6 +113,11 @@ func (z *Numeric) parseUnsigned(s string) (valid bool) {
 		delimPos += len(s)
 	}
 
+	// Weight should fit into int16 as in PostgreSQL
+	if w := mathh.DivideCeilInt(delimPos, numericGroupLen) - 1; w > mathh.MaxInt16 || w < mathh.MinInt16 {
+		return false
+	}
+
 	z.digits, z.weight = parseInteger(s, delimPos)
 	return true
 }
@@ -3,9 +3,12 @@ package pgtypes
 import (
 	"github.com/apaxa-go/helper/strconvh"
 	"math"
+	"math/big"
+	"math/rand"
 	"reflect"
 	"strings"
 	"testing"
+	"testing/quick"
 )
 
 func TestNumeric_FromToString(t *testing.T) {
@@ -163,50 +166,123 @@ var numericTests = []int64{
 	-math.MaxInt32,
 }
 
-func TestNumeric_Add(t *testing.T) {
-	for _, v1 := range numericTests {
-		for _, v2 := range numericTests {
-			var a, b, c Numeric
-			if _, ok := a.SetString(strconvh.FormatInt64(v1)); !ok {
-				t.Errorf("TestAdd. Unable to parse int64 %v", v1)
-			}
-			if _, ok := b.SetString(strconvh.FormatInt64(v2)); !ok {
-				t.Errorf("TestAdd. Unable to parse int64 %v", v2)
-			}
-			c.Add(&a, &b)
+// quickConfig returns a configuration for property based tests with maxCount iterations.
+// Random source has a fixed seed, so failures are reproducible.
+func quickConfig(maxCount int) *quick.Config {
+	return &quick.Config{MaxCount: maxCount, Rand: rand.New(rand.NewSource(1))}
+}
 
-			if s1, s2 := c.String(), strconvh.FormatInt64(v1+v2); s1 != s2 {
-				t.Errorf("TestAdd. %v + %v expected %v, got %v", v1, v2, s2, s1)
-			}
+// numericRat returns exact value of not NaN x as big.Rat.
+// It is computed using internal representation of x, so it may be used for checking String and SetString.
+func numericRat(x *Numeric) *big.Rat {
+	base := big.NewInt(numericBase)
+	num := new(big.Int)
+	for _, d := range x.digits {
+		num.Mul(num, base).Add(num, big.NewInt(int64(d)))
+	}
+	if x.sign == numericNegative {
+		num.Neg(num)
+	}
+
+	exp := int64(x.weight) - int64(len(x.digits)) + 1
+	if exp >= 0 {
+		return new(big.Rat).SetInt(num.Mul(num, new(big.Int).Exp(base, big.NewInt(exp), nil)))
+	}
+	return new(big.Rat).SetFrac(num, new(big.Int).Exp(base, big.NewInt(-exp), nil))
+}
+
+// numericValid reports whether internal representation of x is normalized.
+func numericValid(x *Numeric) bool {
+	switch {
+	case x.sign == numericNaN:
+		return x.weight == 0 && len(x.digits) == 0
+	case len(x.digits) == 0:
+		return x.sign == numericPositive && x.weight == 0
+	case x.sign != numericPositive && x.sign != numericNegative:
+		return false
+	case x.digits[0] == 0 || x.digits[len(x.digits)-1] == 0:
+		return false
+	}
+	for _, d := range x.digits {
+		if d < 0 || d >= numericBase {
+			return false
 		}
 	}
+	return true
 }
 
-func TestNumeric_Cmp(t *testing.T) {
+// numericBinaryProperty returns property which checks that op on Numeric gives the same result as ref on big.Rat.
+// If any of operands is NaN then result of op should be NaN.
+func numericBinaryProperty(op func(z, x, y *Numeric) *Numeric, ref func(z, x, y *big.Rat) *big.Rat) func(x, y *Numeric) bool {
+	return func(x, y *Numeric) bool {
+		var z Numeric
+		op(&z, x, y)
+		if x.IsNaN() || y.IsNaN() {
+			return z.IsNaN() && numericValid(&z)
+		}
+		return numericValid(&z) && numericRat(&z).Cmp(ref(new(big.Rat), numericRat(x), numericRat(y))) == 0
+	}
+}
+
+// checkNumericBinary checks property f on all pairs from numericTests and on random Numerics.
+func checkNumericBinary(t *testing.T, f func(x, y *Numeric) bool) {
 	for _, v1 := range numericTests {
 		for _, v2 := range numericTests {
 			var a, b Numeric
 			if _, ok := a.SetString(strconvh.FormatInt64(v1)); !ok {
-				t.Errorf("TestCmp. Unable to parse int64 %v", v1)
+				t.Errorf("unable to parse int64 %v", v1)
 			}
 			if _, ok := b.SetString(strconvh.FormatInt64(v2)); !ok {
-				t.Errorf("TestCmp. Unable to parse int64 %v", v2)
-			}
-			c := a.Cmp(&b)
-			var r int
-			switch {
-			case v1 < v2:
-				r = -1
-			case v1 == v2:
-				r = 0
-			case v1 > v2:
-				r = 1
+				t.Errorf("unable to parse int64 %v", v2)
 			}
-			if c != r {
-				t.Errorf("TestCmp. %v ? %v expected %v, got %v", v1, v2, r, c)
+			if !f(&a, &b) {
+				t.Errorf("%v,%v: property does not hold", v1, v2)
 			}
 		}
 	}
+	if err := quick.Check(f, quickConfig(1000)); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNumeric_FromToStringQuick(t *testing.T) {
+	f := func(x *Numeric) bool {
+		var n Numeric
+		if _, ok := n.SetString(x.String()); !ok || !numericValid(x) || !numericValid(&n) {
+			return false
+		}
+		if x.IsNaN() {
+			return n.IsNaN()
+		}
+		if r, ok := new(big.Rat).SetString(x.String()); !ok || r.Cmp(numericRat(x)) != 0 {
+			return false
+		}
+		return n.Cmp(x) == 0 && numericRat(&n).Cmp(numericRat(x)) == 0
+	}
+	if err := quick.Check(f, quickConfig(1000)); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNumeric_Add(t *testing.T) {
+	checkNumericBinary(t, numericBinaryProperty((*Numeric).Add, (*big.Rat).Add))
+}
+
+func TestNumeric_Cmp(t *testing.T) {
+	checkNumericBinary(t, func(x, y *Numeric) bool {
+		var r int
+		switch {
+		case x.IsNaN() && y.IsNaN():
+			r = 0
+		case x.IsNaN():
+			r = 1
+		case y.IsNaN():
+			r = -1
+		default:
+			r = numericRat(x).Cmp(numericRat(y))
+		}
+		return x.Cmp(y) == r
+	})
 }
 
 func TestNumeric_Cmp2(t *testing.T) {
@@ -245,40 +321,26 @@ func TestNumeric_Cmp2(t *testing.T) {
 }
 
 func TestNumeric_Sub(t *testing.T) {
-	for _, v1 := range numericTests {
-		for _, v2 := range numericTests {
-			var a, b, c Numeric
-			if _, ok := a.SetString(strconvh.FormatInt64(v1)); !ok {
-				t.Errorf("unable to parse int64 %v", v1)
-			}
-			if _, ok := b.SetString(strconvh.FormatInt64(v2)); !ok {
-				t.Errorf("unable to parse int64 %v", v2)
-			}
-			c.Sub(&a, &b)
-
-			if s1, s2 := c.String(), strconvh.FormatInt64(v1-v2); s1 != s2 {
-				t.Errorf("%v,%v: expected %v, got %v", v1, v2, s2, s1)
-			}
-		}
-	}
+	checkNumericBinary(t, numericBinaryProperty((*Numeric).Sub, (*big.Rat).Sub))
 }
 
 func TestNumeric_Mul(t *testing.T) {
-	for _, v1 := range numericTests {
-		for _, v2 := range numericTests {
-			var a, b, c Numeric
-			if _, ok := a.SetString(strconvh.FormatInt64(v1)); !ok {
-				t.Errorf("TestMul. Unable to parse int64 %v", v1)
-			}
-			if _, ok := b.SetString(strconvh.FormatInt64(v2)); !ok {
-				t.Errorf("TestMul. Unable to parse int64 %v", v2)
-			}
-			c.Mul(&a, &b)
+	checkNumericBinary(t, numericBinaryProperty((*Numeric).Mul, (*big.Rat).Mul))
+}
 
-			if s1, s2 := c.String(), strconvh.FormatInt64(v1*v2); s1 != s2 {
-				t.Errorf("TestMul. %v * %v expected %v, got %v", v1, v2, s2, s1)
-			}
+func TestNumeric_NegAbs(t *testing.T) {
+	f := func(x *Numeric) bool {
+		var neg, abs Numeric
+		neg.Neg(x)
+		abs.Abs(x)
+		if x.IsNaN() {
+			return neg.IsNaN() && abs.IsNaN()
 		}
+		return numericValid(&neg) && numericRat(&neg).Cmp(new(big.Rat).Neg(numericRat(x))) == 0 &&
+			numericValid(&abs) && numericRat(&abs).Cmp(new(big.Rat).Abs(numericRat(x))) == 0
+	}
+	if err := quick.Check(f, quickConfig(1000)); err != nil {
+		t.Error(err)
 	}
 }
 
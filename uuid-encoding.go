@@ -0,0 +1,164 @@
+package pgtypes
+
+import (
+	"encoding/base64"
+)
+
+// Lengths of alternative UUID string representations in chars.
+const (
+	// UUIDBase64Len is a length of base64url representation ("a6e4FJ2tEdGAtADAT9QwyA")
+	UUIDBase64Len = 22
+	// UUIDBase32Len is a length of Crockford base32 representation ("3BMYW197DD278R1D00R17X8C68")
+	UUIDBase32Len = 26
+	// UUIDBase58Len is a length of base58 representation ("EJ34kXpk65cTf16WkQvSko")
+	UUIDBase58Len = 22
+)
+
+// Alphabets of alternative UUID string representations.
+const (
+	uuidBase64Alphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
+	uuidBase32Alphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	uuidBase58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+)
+
+// newUUIDDecodeMap returns map from char to its value in alphabet, 0xff means invalid char.
+// If lower is true then lower case letters are the same as upper case ones.
+func newUUIDDecodeMap(alphabet string, lower bool) (m [256]byte) {
+	for i := range m {
+		m[i] = 0xff
+	}
+	for i := 0; i < len(alphabet); i++ {
+		m[alphabet[i]] = byte(i)
+		if c := alphabet[i]; lower && c >= 'A' && c <= 'Z' {
+			m[c-'A'+'a'] = byte(i)
+		}
+	}
+	return
+}
+
+var (
+	uuidBase64DecodeMap = newUUIDDecodeMap(uuidBase64Alphabet, false)
+	uuidBase32DecodeMap = newUUIDDecodeMap(uuidBase32Alphabet, true)
+	uuidBase58DecodeMap = newUUIDDecodeMap(uuidBase58Alphabet, false)
+)
+
+// divMod divides u (as 128-bit big-endian unsigned integer) by d and returns remainder.
+func (u *UUID) divMod(d uint) byte {
+	var rem uint
+	for i := range u {
+		cur := rem<<8 | uint(u[i])
+		u[i], rem = byte(cur/d), cur%d
+	}
+	return byte(rem)
+}
+
+// mulAdd sets u (as 128-bit big-endian unsigned integer) to u*m+a. It returns false if result overflows.
+func (u *UUID) mulAdd(m, a uint) bool {
+	carry := a
+	for i := len(u) - 1; i >= 0; i-- {
+		cur := uint(u[i])*m + carry
+		u[i], carry = byte(cur), cur>>8
+	}
+	return carry == 0
+}
+
+// encodeUUIDNumber returns u as number in base len(alphabet) with exactly n digits (with leading zero digits).
+func encodeUUIDNumber(u UUID, alphabet string, n int) string {
+	buf := make([]byte, n)
+	for i := n - 1; i >= 0; i-- {
+		buf[i] = alphabet[u.divMod(uint(len(alphabet)))]
+	}
+	return string(buf)
+}
+
+// decodeUUIDDigits checks that s consists of exactly n chars valid according to decode map m and returns their values.
+func decodeUUIDDigits(s string, n int, m *[256]byte) ([]byte, error) {
+	digits := make([]byte, 0, n)
+	for i := 0; i < len(s); i++ {
+		if i == n {
+			return nil, unexpectedUUIDChar(s, s[i:], "unexpected character")
+		}
+		d := m[s[i]]
+		if d == 0xff {
+			return nil, unexpectedUUIDChar(s, s[i:], "invalid character")
+		}
+		digits = append(digits, d)
+	}
+	if len(s) < n {
+		return nil, errUUIDParse(s, len(s), "unexpected end of string")
+	}
+	return digits, nil
+}
+
+// decodeUUIDNumber parses UUID as number in base len(alphabet) with exactly n digits.
+func decodeUUIDNumber(s string, n int, m *[256]byte, base uint) (u UUID, err error) {
+	digits, err := decodeUUIDDigits(s, n, m)
+	if err != nil {
+		return UUID{}, err
+	}
+	for _, d := range digits {
+		if !u.mulAdd(base, uint(d)) {
+			return UUID{}, errUUIDParse(s, 0, "value is out of range")
+		}
+	}
+	return u, nil
+}
+
+// Base64String returns UUID in base64url encoding without padding (RFC 4648), e.g. "a6e4FJ2tEdGAtADAT9QwyA".
+// Result is URL-safe and always has UUIDBase64Len chars.
+func (u UUID) Base64String() string {
+	return base64.RawURLEncoding.EncodeToString(u[:])
+}
+
+// ParseUUIDBase64 parses UUID in base64url encoding without padding (as returned by Base64String).
+// It is strict: string must have exactly UUIDBase64Len chars from URL-safe alphabet and unused bits of the last char must be zero,
+// so each UUID has the only valid representation.
+// If string can not be parsed then returned error is *ParseError.
+func ParseUUIDBase64(s string) (u UUID, err error) {
+	digits, err := decodeUUIDDigits(s, UUIDBase64Len, &uuidBase64DecodeMap)
+	if err != nil {
+		return UUID{}, err
+	}
+	if digits[UUIDBase64Len-1]&0x0f != 0 {
+		return UUID{}, errUUIDParse(s, UUIDBase64Len-1, "non-zero trailing bits")
+	}
+	_, err = base64.RawURLEncoding.Decode(u[:], []byte(s)) // It never fails as s is already checked
+	return
+}
+
+// Base32String returns UUID in Crockford base32 encoding, e.g. "3BMYW197DD278R1D00R17X8C68".
+// Result always has UUIDBase32Len chars in upper case, it is compatible with ULID and preserves sort order of UUIDs.
+func (u UUID) Base32String() string {
+	return encodeUUIDNumber(u, uuidBase32Alphabet, UUIDBase32Len)
+}
+
+// ParseUUIDBase32 parses UUID in Crockford base32 encoding (as returned by Base32String).
+// Letters may be in any case. It is strict: string must have exactly UUIDBase32Len chars, hyphens and letters excluded from alphabet (I, L, O and U) are not allowed,
+// and value must fit 128 bits (so the first char must be from "0" to "7").
+// If string can not be parsed then returned error is *ParseError.
+func ParseUUIDBase32(s string) (UUID, error) {
+	return decodeUUIDNumber(s, UUIDBase32Len, &uuidBase32DecodeMap, uint(len(uuidBase32Alphabet)))
+}
+
+// Base58String returns UUID in base58 encoding (with Bitcoin alphabet), e.g. "EJ34kXpk65cTf16WkQvSko".
+// Result is padded with leading "1" (zero digit) to UUIDBase58Len chars, so it has fixed length and preserves sort order of UUIDs.
+func (u UUID) Base58String() string {
+	return encodeUUIDNumber(u, uuidBase58Alphabet, UUIDBase58Len)
+}
+
+// ParseUUIDBase58 parses UUID in base58 encoding (as returned by Base58String).
+// It is strict: string must have exactly UUIDBase58Len chars from Bitcoin alphabet and value must fit 128 bits.
+// If string can not be parsed then returned error is *ParseError.
+func ParseUUIDBase58(s string) (UUID, error) {
+	return decodeUUIDNumber(s, UUIDBase58Len, &uuidBase58DecodeMap, uint(len(uuidBase58Alphabet)))
+}
+
+// BracesString returns standard string representation of UUID enclosed in braces, e.g. "{6ba7b814-9dad-11d1-80b4-00c04fd430c8}".
+func (u UUID) BracesString() string {
+	return "{" + u.String() + "}"
+}
+
+// URN returns UUID as URN (RFC 4122), e.g. "urn:uuid:6ba7b814-9dad-11d1-80b4-00c04fd430c8".
+func (u UUID) URN() string {
+	return uuidURNPrefix + u.String()
+}
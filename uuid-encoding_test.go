@@ -0,0 +1,101 @@
+package pgtypes
+
+import (
+	"testing"
+	"testing/quick"
+)
+
+func TestUUID_Encodings(t *testing.T) {
+	type testElement struct {
+		u                              UUID
+		base64, base32, base58, braces string
+	}
+
+	max := UUID{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	tests := []testElement{
+		{UUIDNamespaceX500, "a6e4FJ2tEdGAtADAT9QwyA", "3BMYW197DD278R1D00R17X8C68", "EJ34kXpk65cTf16WkQvSko", "{6ba7b814-9dad-11d1-80b4-00c04fd430c8}"},
+		{UUID{}, "AAAAAAAAAAAAAAAAAAAAAA", "00000000000000000000000000", "1111111111111111111111", "{00000000-0000-0000-0000-000000000000}"},
+		{max, "_____________________w", "7ZZZZZZZZZZZZZZZZZZZZZZZZZ", "YcVfxkQb6JRzqk5kF2tNLv", "{ffffffff-ffff-ffff-ffff-ffffffffffff}"},
+		// ULID example from its specification
+		{UUID{0x01, 0x56, 0x3e, 0x3a, 0xb5, 0xd3, 0xd6, 0x76, 0x4c, 0x61, 0xef, 0xb9, 0x93, 0x02, 0xbd, 0x5b}, "AVY-OrXT1nZMYe-5kwK9Ww", "01ARZ3NDEKTSV4RRFFQ69G5FAV", "1AaLyDYFxmKZxXbNo18znE", "{01563e3a-b5d3-d676-4c61-efb99302bd5b}"},
+	}
+
+	for _, v := range tests {
+		if s := v.u.Base64String(); s != v.base64 {
+			t.Errorf("%v: expect base64 %v, got %v", v.u, v.base64, s)
+		}
+		if s := v.u.Base32String(); s != v.base32 {
+			t.Errorf("%v: expect base32 %v, got %v", v.u, v.base32, s)
+		}
+		if s := v.u.Base58String(); s != v.base58 {
+			t.Errorf("%v: expect base58 %v, got %v", v.u, v.base58, s)
+		}
+		if s := v.u.BracesString(); s != v.braces {
+			t.Errorf("%v: expect %v, got %v", v.u, v.braces, s)
+		}
+		if s := v.u.URN(); s != "urn:uuid:"+v.braces[1:37] {
+			t.Errorf("%v: expect %v, got %v", v.u, "urn:uuid:"+v.braces[1:37], s)
+		}
+		if u, err := ParseUUIDBase64(v.base64); err != nil || u != v.u {
+			t.Errorf("%v: expect %v, got %v %v", v.base64, v.u, u, err)
+		}
+		if u, err := ParseUUIDBase32(v.base32); err != nil || u != v.u {
+			t.Errorf("%v: expect %v, got %v %v", v.base32, v.u, u, err)
+		}
+		if u, err := ParseUUIDBase58(v.base58); err != nil || u != v.u {
+			t.Errorf("%v: expect %v, got %v %v", v.base58, v.u, u, err)
+		}
+	}
+
+	if u, err := ParseUUIDBase32("3bmyw197dd278r1d00r17x8c68"); err != nil || u != UUIDNamespaceX500 {
+		t.Errorf("expect %v, got %v %v", UUIDNamespaceX500, u, err)
+	}
+}
+
+func TestUUID_EncodingsQuick(t *testing.T) {
+	f := func(u UUID) bool {
+		u64, err64 := ParseUUIDBase64(u.Base64String())
+		u32, err32 := ParseUUIDBase32(u.Base32String())
+		u58, err58 := ParseUUIDBase58(u.Base58String())
+		uURN, errURN := ParseUUIDAny(u.URN())
+		uBraces, errBraces := ParseUUIDAny(u.BracesString())
+		return err64 == nil && err32 == nil && err58 == nil && errURN == nil && errBraces == nil && u64 == u && u32 == u && u58 == u && uURN == u && uBraces == u
+	}
+	if err := quick.Check(f, quickConfig(100)); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestParseUUIDEncodings(t *testing.T) {
+	type testElement struct {
+		s      string
+		parse  func(string) (UUID, error)
+		offset int
+		reason string
+	}
+
+	tests := []testElement{
+		{"a6e4FJ2tEdGAtADAT9QwyA=", ParseUUIDBase64, 22, "unexpected character '='"},
+		{"a6e4FJ2tEdGAtADAT9Qwy", ParseUUIDBase64, 21, "unexpected end of string"},
+		{"a6e4FJ2tEdGAtADAT9Qwy+", ParseUUIDBase64, 21, "invalid character '+'"},
+		{"a6e4FJ2tEdGAtADAT9QwyB", ParseUUIDBase64, 21, "non-zero trailing bits"},
+		{"", ParseUUIDBase64, 0, "unexpected end of string"},
+		{"8ZZZZZZZZZZZZZZZZZZZZZZZZZ", ParseUUIDBase32, 0, "value is out of range"},
+		{"3BMYW197DD278R1D00R17X8C6O", ParseUUIDBase32, 25, "invalid character 'O'"},
+		{"3BMYW197-DD278R1D00R17X8C68", ParseUUIDBase32, 8, "invalid character '-'"},
+		{"3BMYW197DD278R1D00R17X8C6", ParseUUIDBase32, 25, "unexpected end of string"},
+		{"3BMYW197DD278R1D00R17X8C680", ParseUUIDBase32, 26, "unexpected character '0'"},
+		{"YcVfxkQb6JRzqk5kF2tNLw", ParseUUIDBase58, 0, "value is out of range"},
+		{"zzzzzzzzzzzzzzzzzzzzzz", ParseUUIDBase58, 0, "value is out of range"},
+		{"EJ34kXpk65cTf16WkQvSk0", ParseUUIDBase58, 21, "invalid character '0'"},
+		{"EJ34kXpk65cTf16WkQvSkl", ParseUUIDBase58, 21, "invalid character 'l'"},
+		{"J34kXpk65cTf16WkQvSko", ParseUUIDBase58, 21, "unexpected end of string"},
+	}
+
+	for _, v := range tests {
+		_, err := v.parse(v.s)
+		if e, ok := err.(*ParseError); !ok || e.Type != "UUID" || e.Str != v.s || e.Offset != v.offset || e.Reason != v.reason {
+			t.Errorf("%v: expect error at %v with reason %v, got %#v", v.s, v.offset, v.reason, err)
+		}
+	}
+}
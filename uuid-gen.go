@@ -0,0 +1,213 @@
+package pgtypes
+
+import (
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/binary"
+	"hash"
+	"io"
+	"sync"
+	"time"
+)
+
+// Standard namespaces for name-based UUIDs (versions 3 and 5) defined in RFC 4122.
+var (
+	UUIDNamespaceDNS  = UUID{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	UUIDNamespaceURL  = UUID{0x6b, 0xa7, 0xb8, 0x11, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	UUIDNamespaceOID  = UUID{0x6b, 0xa7, 0xb8, 0x12, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	UUIDNamespaceX500 = UUID{0x6b, 0xa7, 0xb8, 0x14, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+)
+
+// uuidGregorianOffset is a number of 100-nanosecond intervals between the start of Gregorian calendar (1582-10-15 00:00:00 UTC) and the Unix epoch.
+const uuidGregorianOffset = 122192928000000000
+
+// setVersion sets version and RFC 4122 variant bits of u.
+func (u *UUID) setVersion(v byte) {
+	u[6] = u[6]&0x0f | v<<4
+	u[8] = u[8]&0x3f | 0x80
+}
+
+// UUIDGenerator generates random and time-based UUIDs.
+// It is safe for concurrent use (rand is always read under lock, so it does not need to be safe for concurrent use).
+// Time-based UUIDs generated by the same generator are unique and have strictly increasing timestamps, even if clock does not move or moves backward.
+// UUIDs of versions 6 and 7 are also strictly increasing as values (unlike version 1, which stores the low part of timestamp first).
+type UUIDGenerator struct {
+	rand io.Reader
+	now  func() time.Time
+
+	mu sync.Mutex
+	// State of versions 1 and 6
+	ticks    int64 // The last used timestamp (in 100-nanosecond intervals since the start of Gregorian calendar)
+	clockSeq uint16
+	node     [6]byte
+	nodeSet  bool
+	// State of version 7
+	ms  int64  // The last used timestamp (in milliseconds since the Unix epoch)
+	seq uint16 // The last used counter (12 bits)
+}
+
+// NewUUIDGenerator returns UUID generator which reads random bytes from rand and gets current time from now.
+// Nil rand means crypto/rand.Reader and nil now means time.Now.
+// Both can be replaced to make generation deterministic (e.g. in tests).
+func NewUUIDGenerator(rand io.Reader, now func() time.Time) *UUIDGenerator {
+	return &UUIDGenerator{rand: rand, now: now}
+}
+
+// defaultUUIDGenerator is used by package level functions.
+var defaultUUIDGenerator = NewUUIDGenerator(nil, nil)
+
+// read fills b with random bytes.
+func (g *UUIDGenerator) read(b []byte) error {
+	r := g.rand
+	if r == nil {
+		r = rand.Reader
+	}
+	_, err := io.ReadFull(r, b)
+	return err
+}
+
+// clock returns current time.
+func (g *UUIDGenerator) clock() time.Time {
+	if g.now == nil {
+		return time.Now()
+	}
+	return g.now()
+}
+
+// NewV4 returns random UUID (version 4).
+func (g *UUIDGenerator) NewV4() (u UUID, err error) {
+	g.mu.Lock()
+	err = g.read(u[:])
+	g.mu.Unlock()
+	if err != nil {
+		return UUID{}, err
+	}
+	u.setVersion(4)
+	return
+}
+
+// nextTicks returns timestamp, clock sequence and node for the next UUID of version 1 or 6.
+// Clock sequence and node are random, node has multicast bit set (as RFC 4122 requires for random node).
+// If clock has not moved forward since the previous call then timestamp is incremented instead.
+func (g *UUIDGenerator) nextTicks() (ticks int64, clockSeq uint16, node [6]byte, err error) {
+	t := g.clock()
+	ticks = t.Unix()*1e7 + int64(t.Nanosecond()/100) + uuidGregorianOffset
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if !g.nodeSet {
+		var b [8]byte
+		if err = g.read(b[:]); err != nil {
+			return
+		}
+		g.clockSeq = binary.BigEndian.Uint16(b[:2]) & 0x3fff
+		copy(g.node[:], b[2:])
+		g.node[0] |= 0x01
+		g.nodeSet = true
+	}
+	if ticks <= g.ticks {
+		ticks = g.ticks + 1
+	}
+	g.ticks = ticks
+	return ticks, g.clockSeq, g.node, nil
+}
+
+// NewV1 returns time-based UUID (version 1) with random node.
+func (g *UUIDGenerator) NewV1() (u UUID, err error) {
+	ticks, clockSeq, node, err := g.nextTicks()
+	if err != nil {
+		return UUID{}, err
+	}
+	binary.BigEndian.PutUint32(u[0:], uint32(ticks))
+	binary.BigEndian.PutUint16(u[4:], uint16(ticks>>32))
+	binary.BigEndian.PutUint16(u[6:], uint16(ticks>>48))
+	binary.BigEndian.PutUint16(u[8:], clockSeq)
+	copy(u[10:], node[:])
+	u.setVersion(1)
+	return
+}
+
+// NewV6 returns reordered time-based UUID (version 6) with random node.
+// It contains the same fields as version 1, but timestamp is stored from the most significant bits, so UUIDs are sortable by time.
+func (g *UUIDGenerator) NewV6() (u UUID, err error) {
+	ticks, clockSeq, node, err := g.nextTicks()
+	if err != nil {
+		return UUID{}, err
+	}
+	binary.BigEndian.PutUint64(u[0:], uint64(ticks)<<4)
+	binary.BigEndian.PutUint16(u[6:], uint16(ticks&0x0fff))
+	binary.BigEndian.PutUint16(u[8:], clockSeq)
+	copy(u[10:], node[:])
+	u.setVersion(6)
+	return
+}
+
+// NewV7 returns Unix time-based UUID (version 7) with millisecond timestamp, 12-bit counter and 62 random bits.
+// Counter starts from random value in each millisecond and it is incremented for each next UUID within the same millisecond
+// (if it overflows then timestamp is incremented), so UUIDs are strictly increasing.
+func (g *UUIDGenerator) NewV7() (u UUID, err error) {
+	t := g.clock()
+	ms := t.Unix()*1e3 + int64(t.Nanosecond()/1e6)
+
+	g.mu.Lock()
+	if err = g.read(u[6:]); err != nil {
+		g.mu.Unlock()
+		return UUID{}, err
+	}
+	seq := binary.BigEndian.Uint16(u[6:]) & 0x0fff
+	if ms <= g.ms {
+		ms, seq = g.ms, g.seq+1
+		if seq > 0x0fff {
+			ms, seq = ms+1, 0
+		}
+	}
+	g.ms, g.seq = ms, seq
+	g.mu.Unlock()
+
+	binary.BigEndian.PutUint64(u[0:], uint64(ms)<<16|uint64(seq))
+	u.setVersion(7)
+	return
+}
+
+// newUUIDHash returns name-based UUID of version v using hash h.
+func newUUIDHash(h hash.Hash, v byte, namespace UUID, name string) (u UUID) {
+	h.Write(namespace[:])
+	h.Write([]byte(name))
+	copy(u[:], h.Sum(nil))
+	u.setVersion(v)
+	return
+}
+
+// NewUUIDv1 returns time-based UUID (version 1) with random node.
+func NewUUIDv1() (UUID, error) {
+	return defaultUUIDGenerator.NewV1()
+}
+
+// NewUUIDv3 returns name-based UUID (version 3) generated using MD5 hash of namespace and name.
+// Namespace may be one of the standard namespaces (e.g. UUIDNamespaceDNS) or any other UUID.
+func NewUUIDv3(namespace UUID, name string) UUID {
+	return newUUIDHash(md5.New(), 3, namespace, name)
+}
+
+// NewUUIDv4 returns random UUID (version 4) generated using crypto/rand.
+func NewUUIDv4() (UUID, error) {
+	return defaultUUIDGenerator.NewV4()
+}
+
+// NewUUIDv5 returns name-based UUID (version 5) generated using SHA-1 hash of namespace and name.
+// Namespace may be one of the standard namespaces (e.g. UUIDNamespaceDNS) or any other UUID.
+func NewUUIDv5(namespace UUID, name string) UUID {
+	return newUUIDHash(sha1.New(), 5, namespace, name)
+}
+
+// NewUUIDv6 returns reordered time-based UUID (version 6) with random node.
+func NewUUIDv6() (UUID, error) {
+	return defaultUUIDGenerator.NewV6()
+}
+
+// NewUUIDv7 returns Unix time-based UUID (version 7).
+// UUIDs returned by subsequent calls are strictly increasing.
+func NewUUIDv7() (UUID, error) {
+	return defaultUUIDGenerator.NewV7()
+}
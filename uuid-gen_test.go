@@ -0,0 +1,123 @@
+package pgtypes
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+// Test vectors are taken from RFC 9562.
+func TestUUIDGenerator(t *testing.T) {
+	now := func() time.Time { return time.Date(2022, 2, 22, 14, 22, 22, 0, time.FixedZone("", -5*3600)) }
+	rnd := func(b ...byte) *bytes.Reader { return bytes.NewReader(b) }
+
+	type testElement struct {
+		gen func(g *UUIDGenerator) (UUID, error)
+		rnd *bytes.Reader
+		u   string
+	}
+
+	test := []testElement{
+		{(*UUIDGenerator).NewV1, rnd(0x33, 0xc8, 0x9f, 0x6b, 0xde, 0xce, 0xd8, 0x46), "c232ab00-9414-11ec-b3c8-9f6bdeced846"},
+		{(*UUIDGenerator).NewV6, rnd(0x33, 0xc8, 0x9f, 0x6b, 0xde, 0xce, 0xd8, 0x46), "1ec9414c-232a-6b00-b3c8-9f6bdeced846"},
+		{(*UUIDGenerator).NewV1, rnd(0xff, 0xff, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00), "c232ab00-9414-11ec-bfff-010000000000"},
+		{(*UUIDGenerator).NewV4, rnd(0x91, 0x91, 0x08, 0xf7, 0x52, 0xd1, 0x43, 0x20, 0x9b, 0xac, 0xf8, 0x47, 0xdb, 0x41, 0x48, 0xa8), "919108f7-52d1-4320-9bac-f847db4148a8"},
+		{(*UUIDGenerator).NewV4, rnd(0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff), "ffffffff-ffff-4fff-bfff-ffffffffffff"},
+		{(*UUIDGenerator).NewV7, rnd(0x0c, 0xc3, 0x18, 0xc4, 0xdc, 0x0c, 0x0c, 0x07, 0x39, 0x8f), "017f22e2-79b0-7cc3-98c4-dc0c0c07398f"},
+	}
+
+	for _, v := range test {
+		if u, err := v.gen(NewUUIDGenerator(v.rnd, now)); err != nil || u.String() != v.u {
+			t.Errorf("expect %v, got %v %v", v.u, u, err)
+		}
+	}
+}
+
+func TestUUIDGenerator2(t *testing.T) {
+	for _, f := range []func(g *UUIDGenerator) (UUID, error){(*UUIDGenerator).NewV1, (*UUIDGenerator).NewV4, (*UUIDGenerator).NewV6, (*UUIDGenerator).NewV7} {
+		if u, err := f(NewUUIDGenerator(bytes.NewReader([]byte{1, 2, 3}), nil)); err == nil {
+			t.Errorf("expect error, got %v", u)
+		}
+	}
+}
+
+// Time-based UUIDs are strictly increasing even if clock does not move or moves backward.
+func TestUUIDGenerator_Monotonic(t *testing.T) {
+	times := []time.Time{time.Unix(1645557742, 0), time.Unix(1645557742, 0), time.Unix(1645557741, 999999999)}
+	var k int
+	now := func() time.Time {
+		k++
+		return times[(k/3000)%len(times)]
+	}
+	g := NewUUIDGenerator(nil, now)
+
+	for _, f := range []func() (UUID, error){g.NewV6, g.NewV7} {
+		k = 0
+		var prev UUID
+		for j := 0; j < 10000; j++ {
+			u, err := f()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if bytes.Compare(prev[:], u[:]) >= 0 {
+				t.Fatalf("#%v: expect greater than %v, got %v", j, prev, u)
+			}
+			prev = u
+		}
+	}
+
+	seen := make(map[UUID]bool)
+	for j := 0; j < 10000; j++ {
+		u, err := g.NewV1()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if seen[u] {
+			t.Fatalf("#%v: duplicate %v", j, u)
+		}
+		seen[u] = true
+	}
+}
+
+func TestNewUUID(t *testing.T) {
+	type testElement struct {
+		gen func() (UUID, error)
+		v   byte
+	}
+
+	test := []testElement{
+		{NewUUIDv1, 1},
+		{NewUUIDv4, 4},
+		{NewUUIDv6, 6},
+		{NewUUIDv7, 7},
+	}
+
+	for _, v := range test {
+		u1, err1 := v.gen()
+		u2, err2 := v.gen()
+		if err1 != nil || err2 != nil || u1 == u2 || u1[6]>>4 != v.v || u1[8]&0xc0 != 0x80 {
+			t.Errorf("%v: expect two different UUIDs, got %v %v %v %v", v.v, u1, u2, err1, err2)
+		}
+	}
+}
+
+func TestNewUUIDHash(t *testing.T) {
+	type testElement struct {
+		u UUID
+		s string
+	}
+
+	test := []testElement{
+		{NewUUIDv3(UUIDNamespaceDNS, "www.example.com"), "5df41881-3aed-3515-88a7-2f4a814cf09e"},
+		{NewUUIDv5(UUIDNamespaceDNS, "www.example.com"), "2ed6657d-e927-568b-95e1-2665a8aea6a2"},
+		{NewUUIDv3(UUIDNamespaceDNS, "python.org"), "6fa459ea-ee8a-3ca4-894e-db77e160355e"},
+		{NewUUIDv5(UUIDNamespaceDNS, "python.org"), "886313e1-3b8a-5372-9b90-0c9aee199e5d"},
+		{NewUUIDv5(UUIDNamespaceURL, "http://python.org/"), "4c565f0d-3f5a-5890-b41b-20cf47701c5e"},
+	}
+
+	for _, v := range test {
+		if s := v.u.String(); s != v.s {
+			t.Errorf("expect %v, got %v", v.s, s)
+		}
+	}
+}
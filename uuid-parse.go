@@ -0,0 +1,116 @@
+package pgtypes
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// uuidURNPrefix is a prefix of UUID URN (RFC 4122).
+const uuidURNPrefix = "urn:uuid:"
+
+// Possible positions of hyphens in UUID string representation.
+const (
+	uuidHyphensNone      = iota // No hyphens, as in clean representation
+	uuidHyphensCanonical        // Hyphens between groups of 8, 4, 4, 4 and 12 hex digits
+	uuidHyphensAny              // Optional hyphen after any group of 4 hex digits (except the last one)
+)
+
+// errUUIDParse returns error for string s which is not a valid UUID representation.
+// Offset is a byte offset in s where the problem was found.
+func errUUIDParse(s string, offset int, reason string) error {
+	return &ParseError{Type: "UUID", Str: s, Offset: offset, Reason: reason}
+}
+
+// unexpectedUUIDChar returns error for unexpected character at the beginning of str (which is a suffix of s).
+func unexpectedUUIDChar(s, str, reason string) error {
+	r, _ := utf8.DecodeRuneInString(str)
+	return errUUIDParse(s, len(s)-len(str), reason+" "+strconv.QuoteRune(r))
+}
+
+// unhex returns value of hex digit c. ok is false if c is not a hex digit.
+func unhex(c byte) (v byte, ok bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0', true
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10, true
+	case c >= 'A' && c <= 'F':
+		return c - 'A' + 10, true
+	}
+	return 0, false
+}
+
+// parseUUIDDigits parses 32 hex digits of UUID (with hyphens as defined by hyphens) from the beginning of str, which is a suffix of s.
+// It returns rest of str.
+func parseUUIDDigits(s, str string, hyphens int) (u UUID, rest string, err error) {
+	for i := range u {
+		for k := 0; k < 2; k++ {
+			if str == "" {
+				return UUID{}, "", errUUIDParse(s, len(s), "unexpected end of string")
+			}
+			v, ok := unhex(str[0])
+			if !ok {
+				return UUID{}, "", unexpectedUUIDChar(s, str, "invalid character")
+			}
+			u[i] = u[i]<<4 | v
+			str = str[1:]
+		}
+		switch {
+		case hyphens == uuidHyphensCanonical && (i == 3 || i == 5 || i == 7 || i == 9):
+			if str == "" {
+				return UUID{}, "", errUUIDParse(s, len(s), "unexpected end of string")
+			}
+			if str[0] != uuidDelim {
+				return UUID{}, "", unexpectedUUIDChar(s, str, "expected \"-\", got")
+			}
+			str = str[1:]
+		case hyphens == uuidHyphensAny && i%2 == 1 && i < UUIDLen-1:
+			if str != "" && str[0] == uuidDelim {
+				str = str[1:]
+			}
+		}
+	}
+	return u, str, nil
+}
+
+// ParseUUIDAny parses an UUID in any textual form accepted by PostgreSQL: hex digits in upper or lower case, optionally enclosed in braces,
+// with optional hyphen after any group of four digits. It also accepts "urn:uuid:" prefix (RFC 4122 URN).
+// Examples:
+//
+//	6ba7b814-9dad-11d1-80b4-00c04fd430c8
+//	{6BA7B814-9DAD-11D1-80B4-00C04FD430C8}
+//	6ba7b8149dad11d180b400c04fd430c8
+//	6ba7-b814-9dad11d1-80b4-00c0-4fd4-30c8
+//	urn:uuid:6ba7b814-9dad-11d1-80b4-00c04fd430c8
+//
+// If string can not be parsed then returned error is *ParseError.
+func ParseUUIDAny(s string) (UUID, error) {
+	str := s
+	if len(str) >= len(uuidURNPrefix) && strings.EqualFold(str[:len(uuidURNPrefix)], uuidURNPrefix) {
+		str = str[len(uuidURNPrefix):]
+	}
+	braces := str != "" && str[0] == '{'
+	if braces {
+		str = str[1:]
+	}
+
+	u, str, err := parseUUIDDigits(s, str, uuidHyphensAny)
+	if err != nil {
+		return UUID{}, err
+	}
+
+	if braces {
+		if str == "" {
+			return UUID{}, errUUIDParse(s, len(s), "missing \"}\"")
+		}
+		if str[0] != '}' {
+			return UUID{}, unexpectedUUIDChar(s, str, "expected \"}\", got")
+		}
+		str = str[1:]
+	}
+	if str != "" {
+		return UUID{}, unexpectedUUIDChar(s, str, "unexpected character")
+	}
+	return u, nil
+}
@@ -0,0 +1,127 @@
+package pgtypes
+
+import (
+	"testing"
+)
+
+func TestParseUUIDAny(t *testing.T) {
+	type testElement struct {
+		s string
+		u UUID
+	}
+
+	u := UUID{0x6b, 0xa7, 0xb8, 0x14 /**/, 0x9d, 0xad /**/, 0x11, 0xd1 /**/, 0x80, 0xb4 /**/, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	tests := []testElement{
+		{"6ba7b814-9dad-11d1-80b4-00c04fd430c8", u},
+		{"6BA7B814-9DAD-11D1-80B4-00C04FD430C8", u},
+		{"{6ba7b814-9dad-11d1-80b4-00c04fd430c8}", u},
+		{"6ba7b8149dad11d180b400c04fd430c8", u},
+		{"{6ba7b8149dad11d180b400c04fd430c8}", u},
+		{"6ba7-b814-9dad-11d1-80b4-00c0-4fd4-30c8", u},
+		{"{6ba7b814-9dad11d1-80b400c0-4fd430c8}", u},
+		{"urn:uuid:6ba7b814-9dad-11d1-80b4-00c04fd430c8", u},
+		{"URN:UUID:6ba7b8149dad11d180b400c04fd430c8", u},
+		{"00000000-0000-0000-0000-000000000000", UUID{}},
+	}
+
+	for _, v := range tests {
+		if r, err := ParseUUIDAny(v.s); err != nil || r != v.u {
+			t.Errorf("%v: expect %v, got %v %v", v.s, v.u, r, err)
+		}
+	}
+}
+
+// PostgreSQL rejects all these strings too.
+func TestParseUUIDAny2(t *testing.T) {
+	type testElement struct {
+		s      string
+		offset int
+		reason string
+	}
+
+	tests := []testElement{
+		{"", 0, "unexpected end of string"},
+		{"6ba7b814-9dad-11d1-80b4-00c04fd430c", 35, "unexpected end of string"},
+		{"6ba7b814-9dad-11d1-80b4-00c04fd430c8a", 36, "unexpected character 'a'"},
+		{"6ba7b814-9dad-11d1-80b4-00c04fd430c8-", 36, "unexpected character '-'"},
+		{"6ba7b814-9dad-11d1-80b4-00c04fd430x8", 34, "invalid character 'x'"},
+		{"6ba7b81-49dad-11d1-80b4-00c04fd430c8", 7, "invalid character '-'"},
+		{"6ba7b814--9dad-11d1-80b4-00c04fd430c8", 9, "invalid character '-'"},
+		{"-6ba7b814-9dad-11d1-80b4-00c04fd430c8", 0, "invalid character '-'"},
+		{"6ba7b814-9dad-11d1-80b4-00c04fd430ж8", 34, "invalid character 'ж'"},
+		{"{6ba7b814-9dad-11d1-80b4-00c04fd430c8", 37, `missing "}"`},
+		{"{6ba7b814-9dad-11d1-80b4-00c04fd430c8)", 37, `expected "}", got ')'`},
+		{"6ba7b814-9dad-11d1-80b4-00c04fd430c8}", 36, "unexpected character '}'"},
+		{"{{6ba7b814-9dad-11d1-80b4-00c04fd430c8}}", 1, "invalid character '{'"},
+		{"urn:uuid:{6ba7b814-9dad-11d1-80b4-00c04fd430c8", 46, `missing "}"`},
+		{"uuid:6ba7b814-9dad-11d1-80b4-00c04fd430c8", 0, "invalid character 'u'"},
+	}
+
+	for _, v := range tests {
+		_, err := ParseUUIDAny(v.s)
+		if e, ok := err.(*ParseError); !ok || e.Type != "UUID" || e.Str != v.s || e.Offset != v.offset || e.Reason != v.reason {
+			t.Errorf("%v: expect error at %v with reason %v, got %#v", v.s, v.offset, v.reason, err)
+		}
+	}
+}
+
+func TestParseUUID2(t *testing.T) {
+	type testElement struct {
+		s      string
+		clean  bool
+		offset int
+		reason string
+	}
+
+	tests := []testElement{
+		{"0000000-0000-0000-0000-000000000000", false, 7, "invalid character '-'"},
+		{"000000000-0000-0000-0000-00000000000", false, 8, `expected "-", got '0'`},
+		{"00000000-0000-0000-0000+000000000000", false, 23, `expected "-", got '+'`},
+		{"00000000-0000-0000-0000-0000000x0000", false, 31, "invalid character 'x'"},
+		{"00000000-0000-0000-0000-0000000000000", false, 36, "unexpected character '0'"},
+		{"00000000-0000-0000", false, 18, "unexpected end of string"},
+		{"{00000000-0000-0000-0000-000000000000}", false, 0, "invalid character '{'"},
+		{"0000000000000000000000000000000", true, 31, "unexpected end of string"},
+		{"0000x000000000000000000000000000", true, 4, "invalid character 'x'"},
+		{"00000000-0000-0000-0000-000000000000", true, 8, "invalid character '-'"},
+		{"000000000000000000000000000000000", true, 32, "unexpected character '0'"},
+	}
+
+	for _, v := range tests {
+		var err error
+		if v.clean {
+			_, err = ParseUUIDClean(v.s)
+		} else {
+			_, err = ParseUUID(v.s)
+		}
+		if e, ok := err.(*ParseError); !ok || e.Type != "UUID" || e.Str != v.s || e.Offset != v.offset || e.Reason != v.reason {
+			t.Errorf("%v: expect error at %v with reason %v, got %#v", v.s, v.offset, v.reason, err)
+		}
+	}
+}
+
+func TestUUID_ScanAny(t *testing.T) {
+	type testElement struct {
+		src interface{}
+		u   UUID
+		err bool
+	}
+
+	u := UUID{0x6b, 0xa7, 0xb8, 0x14 /**/, 0x9d, 0xad /**/, 0x11, 0xd1 /**/, 0x80, 0xb4 /**/, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	tests := []testElement{
+		{"{6BA7B814-9DAD-11D1-80B4-00C04FD430C8}", u, false},
+		{[]byte("6ba7b8149dad11d180b400c04fd430c8"), u, false},
+		{[]byte("urn:uuid:6ba7b814-9dad-11d1-80b4-00c04fd430c8"), u, false},
+		{u[:], u, false},
+		{"6ba7b814", UUID{}, true},
+		{[]byte("6ba7b814"), UUID{}, true},
+		{1, UUID{}, true},
+	}
+
+	for _, v := range tests {
+		var r UUID
+		if err := r.Scan(v.src); (err != nil) != v.err || r != v.u {
+			t.Errorf("%v: expect %v %v, got %v %v", v.src, v.u, v.err, r, err)
+		}
+	}
+}
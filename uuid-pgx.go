@@ -23,11 +23,8 @@ func (u *UUID) ScanPgx(vr *pgx.ValueReader) error {
 
 	switch vr.Type().FormatCode {
 	case pgx.TextFormatCode:
-		if vr.Len() != UUIDStringLen {
-			return pgx.SerializationError(fmt.Sprintf("Received UUID string with invalid length: %d", vr.Len())) // It is hard cover this case with test
-		}
 		var err error
-		if *u, err = ParseUUID(vr.ReadString(vr.Len())); err != nil {
+		if *u, err = ParseUUIDAny(vr.ReadString(vr.Len())); err != nil {
 			return pgx.SerializationError(fmt.Sprintf("Received invalid UUID string: %v", err.Error())) // It is hard cover this case with test
 		}
 	case pgx.BinaryFormatCode:
@@ -0,0 +1,22 @@
+package pgtypes
+
+import (
+	"math/rand"
+	"reflect"
+)
+
+// Generate implements the quick.Generator interface.
+// It returns pseudo-random UUID. With increased frequency it returns zero UUID and UUID with all bits set.
+func (UUID) Generate(rnd *rand.Rand, size int) reflect.Value {
+	var u UUID
+	switch rnd.Intn(8) {
+	case 0:
+	case 1:
+		for i := range u {
+			u[i] = 0xff
+		}
+	default:
+		rnd.Read(u[:])
+	}
+	return reflect.ValueOf(u)
+}
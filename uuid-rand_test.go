@@ -0,0 +1,22 @@
+package pgtypes
+
+import (
+	"testing"
+	"testing/quick"
+)
+
+func TestUUID_Generate(t *testing.T) {
+	var zero, other bool
+	f := func(u UUID) bool {
+		zero = zero || u.IsZero()
+		other = other || !u.IsZero()
+		v, err := ParseUUID(u.String())
+		return err == nil && v == u
+	}
+	if err := quick.Check(f, quickConfig(100)); err != nil {
+		t.Error(err)
+	}
+	if !zero || !other {
+		t.Errorf("expect zero and non zero UUIDs, got %v %v", zero, other)
+	}
+}
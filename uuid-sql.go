@@ -8,20 +8,18 @@ import (
 // Scan implements the sql.Scanner interface.
 // Can parse:
 //  bytes as raw UUID representations (as-is)
-//  string/bytes as default UUID string representation
+//  string/bytes as any UUID string representation accepted by ParseUUIDAny
 func (u *UUID) Scan(src interface{}) (err error) {
 	switch src := src.(type) {
 	case []byte:
-		switch len(src) {
-		case UUIDLen:
+		if len(src) == UUIDLen {
 			*u, err = ParseUUIDBytes(src)
 			return
-		case UUIDStringLen:
-			*u, err = ParseUUID(string(src))
-			return
 		}
+		*u, err = ParseUUIDAny(string(src))
+		return
 	case string:
-		*u, err = ParseUUID(src)
+		*u, err = ParseUUIDAny(src)
 		return
 	}
 
@@ -0,0 +1,103 @@
+package pgtypes
+
+import (
+	"encoding/binary"
+	"github.com/apaxa-go/helper/strconvh"
+	"time"
+)
+
+// UUIDVariant is a variant (layout) of UUID.
+type UUIDVariant uint8
+
+// Possible UUID variants.
+const (
+	UUIDVariantNCS       UUIDVariant = iota // Reserved, NCS backward compatibility
+	UUIDVariantRFC4122                      // Layout specified in RFC 4122 and RFC 9562
+	UUIDVariantMicrosoft                    // Reserved, Microsoft Corporation backward compatibility
+	UUIDVariantFuture                       // Reserved for future definition
+)
+
+// String returns name of variant.
+func (v UUIDVariant) String() string {
+	switch v {
+	case UUIDVariantNCS:
+		return "NCS"
+	case UUIDVariantRFC4122:
+		return "RFC 4122"
+	case UUIDVariantMicrosoft:
+		return "Microsoft"
+	case UUIDVariantFuture:
+		return "Future"
+	default:
+		return "UUIDVariant(" + strconvh.FormatUint8(uint8(v)) + ")"
+	}
+}
+
+// Variant returns variant of UUID (determined by the most significant bits of octet 8).
+func (u UUID) Variant() UUIDVariant {
+	switch {
+	case u[8]&0x80 == 0:
+		return UUIDVariantNCS
+	case u[8]&0xc0 == 0x80:
+		return UUIDVariantRFC4122
+	case u[8]&0xe0 == 0xc0:
+		return UUIDVariantMicrosoft
+	default:
+		return UUIDVariantFuture
+	}
+}
+
+// Version returns version of UUID (the most significant 4 bits of octet 6), e.g. 4 for random UUID.
+// Version is meaningful only for UUIDVariantRFC4122, for other variants it returns 0.
+func (u UUID) Version() uint8 {
+	if u.Variant() != UUIDVariantRFC4122 {
+		return 0
+	}
+	return u[6] >> 4
+}
+
+// gregorianTicks returns timestamp of UUID of version 1 or 6 in 100-nanosecond intervals since the start of Gregorian calendar.
+func (u UUID) gregorianTicks() (int64, bool) {
+	switch u.Version() {
+	case 1:
+		return int64(binary.BigEndian.Uint16(u[6:])&0x0fff)<<48 | int64(binary.BigEndian.Uint16(u[4:]))<<32 | int64(binary.BigEndian.Uint32(u[0:])), true
+	case 6:
+		return int64(binary.BigEndian.Uint64(u[0:])>>4&^0x0fff) | int64(binary.BigEndian.Uint16(u[6:])&0x0fff), true
+	default:
+		return 0, false
+	}
+}
+
+// Time returns timestamp embedded into time-based UUID (versions 1, 6 and 7) in UTC.
+// Timestamp of versions 1 and 6 has 100-nanosecond precision, of version 7 - millisecond precision.
+// ok is false if UUID is not time-based.
+func (u UUID) Time() (t time.Time, ok bool) {
+	if ticks, ok := u.gregorianTicks(); ok {
+		ticks -= uuidGregorianOffset
+		return time.Unix(ticks/1e7, ticks%1e7*100).UTC(), true
+	}
+	if u.Version() == 7 {
+		ms := int64(binary.BigEndian.Uint64(u[0:]) >> 16)
+		return time.Unix(ms/1e3, ms%1e3*1e6).UTC(), true
+	}
+	return time.Time{}, false
+}
+
+// ClockSequence returns 14-bit clock sequence of time-based UUID of versions 1 and 6.
+// ok is false for other UUIDs.
+func (u UUID) ClockSequence() (seq uint16, ok bool) {
+	if _, ok = u.gregorianTicks(); !ok {
+		return 0, false
+	}
+	return binary.BigEndian.Uint16(u[8:]) & 0x3fff, true
+}
+
+// Node returns node (usually MAC address or random value with multicast bit set) of time-based UUID of versions 1 and 6.
+// ok is false for other UUIDs.
+func (u UUID) Node() (node [6]byte, ok bool) {
+	if _, ok = u.gregorianTicks(); !ok {
+		return
+	}
+	copy(node[:], u[10:])
+	return node, true
+}
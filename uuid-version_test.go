@@ -0,0 +1,108 @@
+package pgtypes
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUUIDVariant_String(t *testing.T) {
+	type testElement struct {
+		v UUIDVariant
+		s string
+	}
+
+	test := []testElement{
+		{UUIDVariantNCS, "NCS"},
+		{UUIDVariantRFC4122, "RFC 4122"},
+		{UUIDVariantMicrosoft, "Microsoft"},
+		{UUIDVariantFuture, "Future"},
+		{UUIDVariant(100), "UUIDVariant(100)"},
+	}
+
+	for _, v := range test {
+		if s := v.v.String(); s != v.s {
+			t.Errorf("%d: expect %v, got %v", v.v, v.s, s)
+		}
+	}
+}
+
+// Test vectors are taken from RFC 9562.
+func TestUUID_Version(t *testing.T) {
+	type testElement struct {
+		s        string
+		variant  UUIDVariant
+		version  uint8
+		t        time.Time
+		clockSeq uint16
+		node     [6]byte
+		timeOk   bool
+		nodeOk   bool
+	}
+
+	ts := time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC)
+	node := [6]byte{0x9f, 0x6b, 0xde, 0xce, 0xd8, 0x46}
+	test := []testElement{
+		{"c232ab00-9414-11ec-b3c8-9f6bdeced846", UUIDVariantRFC4122, 1, ts, 0x33c8, node, true, true},
+		{"1ec9414c-232a-6b00-b3c8-9f6bdeced846", UUIDVariantRFC4122, 6, ts, 0x33c8, node, true, true},
+		{"017f22e2-79b0-7cc3-98c4-dc0c0c07398f", UUIDVariantRFC4122, 7, ts, 0, [6]byte{}, true, false},
+		{"5df41881-3aed-3515-88a7-2f4a814cf09e", UUIDVariantRFC4122, 3, time.Time{}, 0, [6]byte{}, false, false},
+		{"919108f7-52d1-4320-9bac-f847db4148a8", UUIDVariantRFC4122, 4, time.Time{}, 0, [6]byte{}, false, false},
+		{"2ed6657d-e927-568b-95e1-2665a8aea6a2", UUIDVariantRFC4122, 5, time.Time{}, 0, [6]byte{}, false, false},
+		{"00000000-0000-0000-0000-000000000000", UUIDVariantNCS, 0, time.Time{}, 0, [6]byte{}, false, false},
+		{"ffffffff-ffff-ffff-ffff-ffffffffffff", UUIDVariantFuture, 0, time.Time{}, 0, [6]byte{}, false, false},
+		{"00000000-0000-1000-c000-000000000000", UUIDVariantMicrosoft, 0, time.Time{}, 0, [6]byte{}, false, false},
+		{"00000000-0000-1000-8000-000000000000", UUIDVariantRFC4122, 1, time.Date(1582, 10, 15, 0, 0, 0, 0, time.UTC), 0, [6]byte{}, true, true},
+		{"ffffffff-ffff-1fff-bfff-ffffffffffff", UUIDVariantRFC4122, 1, time.Date(5236, 3, 31, 21, 21, 0, 684697500, time.UTC), 0x3fff, [6]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, true, true},
+		{"ffffffff-ffff-7fff-bfff-ffffffffffff", UUIDVariantRFC4122, 7, time.Date(10889, 8, 2, 5, 31, 50, 655000000, time.UTC), 0, [6]byte{}, true, false},
+	}
+
+	for _, v := range test {
+		u, err := ParseUUID(v.s)
+		if err != nil {
+			t.Errorf("%v: %v", v.s, err)
+			continue
+		}
+		if r := u.Variant(); r != v.variant {
+			t.Errorf("%v: expect variant %v, got %v", v.s, v.variant, r)
+		}
+		if r := u.Version(); r != v.version {
+			t.Errorf("%v: expect version %v, got %v", v.s, v.version, r)
+		}
+		if r, ok := u.Time(); ok != v.timeOk || !r.Equal(v.t) || ok && r.Location() != time.UTC {
+			t.Errorf("%v: expect time %v %v, got %v %v", v.s, v.t, v.timeOk, r, ok)
+		}
+		if r, ok := u.ClockSequence(); ok != v.nodeOk || r != v.clockSeq {
+			t.Errorf("%v: expect clock sequence %v %v, got %v %v", v.s, v.clockSeq, v.nodeOk, r, ok)
+		}
+		if r, ok := u.Node(); ok != v.nodeOk || r != v.node {
+			t.Errorf("%v: expect node %v %v, got %v %v", v.s, v.node, v.nodeOk, r, ok)
+		}
+	}
+}
+
+// Timestamp of generated UUID is the time of generation.
+func TestUUID_Time(t *testing.T) {
+	ts := time.Date(2021, 3, 14, 1, 59, 26, 535897932, time.UTC)
+	g := NewUUIDGenerator(nil, func() time.Time { return ts })
+
+	type testElement struct {
+		gen func() (UUID, error)
+		t   time.Time
+	}
+
+	test := []testElement{
+		{g.NewV1, ts.Truncate(100)},
+		{g.NewV6, ts.Truncate(100).Add(100)},
+		{g.NewV7, ts.Truncate(time.Millisecond)},
+	}
+
+	for _, v := range test {
+		u, err := v.gen()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r, ok := u.Time(); !ok || !r.Equal(v.t) {
+			t.Errorf("%v: expect %v, got %v %v", u, v.t, r, ok)
+		}
+	}
+}
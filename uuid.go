@@ -52,64 +52,35 @@ const (
 	part4From = part3From + part3Len
 )
 
-// UUID representation compliant with specification described in RFC 4122.
+// UUID representation compliant with specification described in RFC 4122 (and RFC 9562).
+// Any 16 bytes are accepted as UUID, use Variant and Version to check layout.
 type UUID [UUIDLen]byte
 
 var zeroUUID = UUID{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
 
 // ParseUUID parses an UUID standard string representation (like "6ba7b814-9dad-11d1-80b4-00c04fd430c8").
-func ParseUUID(s string) (u UUID, err error) {
-	if len(s) != UUIDStringLen {
-		err = errors.New("invalid UUID string length")
-		return
-	}
-
-	if s[sDelim0At] != uuidDelim || s[sDelim1At] != uuidDelim || s[sDelim2At] != uuidDelim || s[sDelim3At] != uuidDelim {
-		err = errors.New("invalid UUID string delimiters")
-		return
-	}
-
-	b := []byte(s)
-
-	if l, e := hex.Decode(u[part0From:part1From], b[sPart0From:sPart0To]); l != part0Len || e != nil {
-		err = errors.New("invalid UUID part 1")
-		return
-	}
-
-	if l, e := hex.Decode(u[part1From:part2From], b[sPart1From:sPart1To]); l != part1Len || e != nil {
-		err = errors.New("invalid UUID part 2")
-		return
-	}
-
-	if l, e := hex.Decode(u[part2From:part3From], b[sPart2From:sPart2To]); l != part2Len || e != nil {
-		err = errors.New("invalid UUID part 3")
-		return
-	}
-
-	if l, e := hex.Decode(u[part3From:part4From], b[sPart3From:sPart3To]); l != part3Len || e != nil {
-		err = errors.New("invalid UUID part 4")
-		return
-	}
-
-	if l, e := hex.Decode(u[part4From:], b[sPart4From:sPart4To]); l != part4Len || e != nil {
-		err = errors.New("invalid UUID part 5")
-		return
-	}
-	return
+// Use ParseUUIDAny to parse other forms.
+// If string can not be parsed then returned error is *ParseError.
+func ParseUUID(s string) (UUID, error) {
+	return parseUUIDStrict(s, uuidHyphensCanonical)
 }
 
 // ParseUUIDClean parses an UUID clean string representation (like "6ba7b8149dad11d180b400c04fd430c8").
-func ParseUUIDClean(s string) (u UUID, err error) {
-	if len(s) != UUIDCleanStringLen {
-		err = errors.New("Invalid UUID clean string length")
-		return
-	}
+// If string can not be parsed then returned error is *ParseError.
+func ParseUUIDClean(s string) (UUID, error) {
+	return parseUUIDStrict(s, uuidHyphensNone)
+}
 
-	if l, e := hex.Decode(u[:], []byte(s)); l != UUIDLen || e != nil {
-		err = errors.New("Unable to parse UUID from clean string")
+// parseUUIDStrict parses string which contains only hex digits of UUID and hyphens as defined by hyphens.
+func parseUUIDStrict(s string, hyphens int) (UUID, error) {
+	u, rest, err := parseUUIDDigits(s, s, hyphens)
+	if err != nil {
+		return UUID{}, err
 	}
-
-	return
+	if rest != "" {
+		return UUID{}, unexpectedUUIDChar(s, rest, "unexpected character")
+	}
+	return u, nil
 }
 
 // ParseUUIDBytes parses byte slice (as-is) with UUID.